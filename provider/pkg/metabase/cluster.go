@@ -0,0 +1,128 @@
+package metabase
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ecs"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var clusterARNPattern = regexp.MustCompile(`^arn:aws[a-z-]*:ecs:[a-z0-9-]+:\d{12}:cluster/([A-Za-z0-9_-]+)$`)
+
+// ParseClusterARN returns the name of the ECS cluster in the ARN.
+func ParseClusterARN(arn string) (string, error) {
+	match := clusterARNPattern.FindStringSubmatch(arn)
+	if match == nil {
+		return "", fmt.Errorf("ecsClusterArn must be an ECS cluster ARN such as \"arn:aws:ecs:us-east-1:123456789012:cluster/shared\", got %q", arn)
+	}
+	return match[1], nil
+}
+
+// CheckExistingCluster makes sure the cluster Metabase is deployed to exists and is active, and
+// returns its ARN once it's been checked. Every active cluster can run tasks with the FARGATE launch
+// type, capacity providers such as FARGATE_SPOT have to be associated with the cluster by its owner.
+func (m *MetabaseResourceConstructor) CheckExistingCluster(arn pulumi.StringInput) pulumi.StringOutput {
+	return arn.ToStringOutput().ApplyT(func(arn string) (string, error) {
+		if _, err := ParseClusterARN(arn); err != nil {
+			return "", err
+		}
+
+		cluster, err := ecs.LookupCluster(m.ctx, &ecs.LookupClusterArgs{ClusterName: arn})
+		if err != nil {
+			return "", fmt.Errorf("looking up ecsClusterArn %q: %w", arn, err)
+		}
+		if cluster.Status != "ACTIVE" {
+			return "", fmt.Errorf("ecsClusterArn %q is %s, Metabase can only be deployed to an ACTIVE cluster", arn, cluster.Status)
+		}
+		return arn, nil
+	}).(pulumi.StringOutput)
+}
+
+// ClusterSettings configures the ECS cluster created for Metabase.
+type ClusterSettings struct {
+	// Enables or disables CloudWatch Container Insights, the account default is used when nil.
+	ContainerInsights *bool
+	Tags              pulumi.StringMapInput
+	// The strategy used by tasks started in the cluster without a launch type or strategy of their own.
+	DefaultCapacityProviderStrategy []CapacityProviderStrategy
+}
+
+// CapacityProviderStrategy is an item of a cluster's default capacity provider strategy.
+type CapacityProviderStrategy struct {
+	CapacityProvider string
+	Weight           int
+	Base             int
+}
+
+// Validate checks the default capacity provider strategy only uses the Fargate capacity providers
+// associated with the cluster, and that a single one of them has a base.
+func (s *ClusterSettings) Validate() error {
+	if s == nil {
+		return nil
+	}
+	providers := map[string]bool{}
+	withBase := 0
+	for _, strategy := range s.DefaultCapacityProviderStrategy {
+		switch strategy.CapacityProvider {
+		case "FARGATE", "FARGATE_SPOT":
+		default:
+			return fmt.Errorf("cluster.defaultCapacityProviderStrategy capacityProvider must be either FARGATE or FARGATE_SPOT, got %q", strategy.CapacityProvider)
+		}
+		if providers[strategy.CapacityProvider] {
+			return fmt.Errorf("cluster.defaultCapacityProviderStrategy contains %s more than once", strategy.CapacityProvider)
+		}
+		providers[strategy.CapacityProvider] = true
+		if strategy.Weight < 0 || strategy.Weight > 1000 {
+			return fmt.Errorf("cluster.defaultCapacityProviderStrategy weight must be between 0 and 1000, got %d", strategy.Weight)
+		}
+		if strategy.Base < 0 || strategy.Base > 100000 {
+			return fmt.Errorf("cluster.defaultCapacityProviderStrategy base must be between 0 and 100000, got %d", strategy.Base)
+		}
+		if strategy.Base > 0 {
+			withBase++
+		}
+	}
+	if withBase > 1 {
+		return fmt.Errorf("cluster.defaultCapacityProviderStrategy can only set a base on one capacity provider")
+	}
+	return nil
+}
+
+// clusterArgs returns the arguments of the cluster created for Metabase. The Container Insights
+// setting is only managed when it's set, so the account default keeps applying otherwise.
+func (s *ClusterSettings) clusterArgs() *ecs.ClusterArgs {
+	args := &ecs.ClusterArgs{}
+	if s == nil {
+		return args
+	}
+	if s.ContainerInsights != nil {
+		value := "disabled"
+		if *s.ContainerInsights {
+			value = "enabled"
+		}
+		args.Settings = ecs.ClusterSettingArray{
+			ecs.ClusterSettingArgs{
+				Name:  pulumi.String("containerInsights"),
+				Value: pulumi.String(value),
+			},
+		}
+	}
+	args.Tags = s.Tags
+	return args
+}
+
+func (s *ClusterSettings) defaultCapacityProviderStrategies() ecs.ClusterCapacityProvidersDefaultCapacityProviderStrategyArray {
+	if s == nil {
+		return nil
+	}
+	var strategies ecs.ClusterCapacityProvidersDefaultCapacityProviderStrategyArray
+	for _, strategy := range s.DefaultCapacityProviderStrategy {
+		strategies = append(strategies, ecs.ClusterCapacityProvidersDefaultCapacityProviderStrategyArgs{
+			CapacityProvider: pulumi.String(strategy.CapacityProvider),
+			Weight:           pulumi.IntPtr(strategy.Weight),
+			Base:             pulumi.IntPtr(strategy.Base),
+		})
+	}
+	return strategies
+}
@@ -0,0 +1,90 @@
+package metabase
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// The Aurora MySQL engine the application database runs on.
+const AuroraMySQLEngine = "aurora-mysql"
+
+// DefaultMySQLEngineVersion is the Aurora MySQL version new clusters get when `database.engineVersion`
+// isn't set. It's compatible with MySQL 8.0, which every Metabase release supports.
+const DefaultMySQLEngineVersion = "8.0.mysql_aurora.3.04.0"
+
+// LegacyMySQLEngineVersion is the former default, which clusters created with it keep since moving
+// them to Aurora MySQL 3 replaces them.
+const LegacyMySQLEngineVersion = "5.7.mysql_aurora.2.08.3"
+
+// databaseCompatibility is a range of Metabase releases and the application database engine versions
+// they support. A zero `until` means the range is still open.
+type databaseCompatibility struct {
+	from          Version
+	until         Version
+	engine        string
+	mysqlVersions []string
+}
+
+// Metabase's supported application databases, see
+// https://www.metabase.com/docs/latest/installation-and-operation/configuring-application-database.
+// Metabase 47 dropped MySQL 5.7, which is the only version Aurora MySQL 2 is compatible with.
+var databaseCompatibilities = []databaseCompatibility{
+	{
+		from:          Version{},
+		until:         Version{Major: 47},
+		engine:        AuroraMySQLEngine,
+		mysqlVersions: []string{"5.7", "8.0"},
+	},
+	{
+		from:          Version{Major: 47},
+		engine:        AuroraMySQLEngine,
+		mysqlVersions: []string{"8.0"},
+	},
+}
+
+// latestRelease stands for the release `latest` points to, which is newer than any release that
+// a closed range was written for.
+var latestRelease = Version{Major: math.MaxInt32}
+
+func (c databaseCompatibility) contains(v Version) bool {
+	return !v.Less(c.from) && (c.until == Version{} || v.Less(c.until))
+}
+
+// mysqlVersion returns the MySQL version an Aurora MySQL engine version is compatible with, such as
+// `5.7` for `5.7.mysql_aurora.2.08.3`.
+func mysqlVersion(engineVersion string) string {
+	parts := strings.SplitN(engineVersion, ".", 3)
+	if len(parts) < 2 {
+		return engineVersion
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// CheckDatabaseCompatibility returns an error when the Metabase version tag doesn't support the
+// application database engine version. `latest` is checked as the newest release.
+func CheckDatabaseCompatibility(tag, engine, engineVersion string) error {
+	version, ok := ParseVersion(tag)
+	if tag == "latest" {
+		version, ok = latestRelease, true
+	}
+	if !ok {
+		return nil
+	}
+
+	mysql := mysqlVersion(engineVersion)
+	for _, c := range databaseCompatibilities {
+		if !c.contains(version) || c.engine != engine {
+			continue
+		}
+		for _, supported := range c.mysqlVersions {
+			if supported == mysql {
+				return nil
+			}
+		}
+		return fmt.Errorf("metabaseVersion %s doesn't support %s %s as its application database, "+
+			"database.engineVersion %q must be a MySQL %s compatible version",
+			tag, engine, mysql, engineVersion, strings.Join(c.mysqlVersions, " or "))
+	}
+	return fmt.Errorf("metabaseVersion %s doesn't support %s as its application database", tag, engine)
+}
@@ -0,0 +1,33 @@
+package metabase
+
+import "testing"
+
+func TestCheckDatabaseCompatibility(t *testing.T) {
+	tests := []struct {
+		name          string
+		tag           string
+		engineVersion string
+		wantErr       bool
+	}{
+		{name: "46 on 5.7", tag: "v0.46.6", engineVersion: "5.7.mysql_aurora.2.08.3"},
+		{name: "46 on 8.0", tag: "v0.46.6", engineVersion: "8.0.mysql_aurora.3.04.0"},
+		{name: "enterprise 46 on 5.7", tag: "v1.46.6.1", engineVersion: "5.7.mysql_aurora.2.08.3"},
+		{name: "47 on 5.7", tag: "v0.47.0", engineVersion: "5.7.mysql_aurora.2.08.3", wantErr: true},
+		{name: "47 on 8.0", tag: "v0.47.0", engineVersion: "8.0.mysql_aurora.3.04.0"},
+		{name: "47 on partial 8.0", tag: "v1.47.1", engineVersion: "8.0"},
+		{name: "latest on 5.7", tag: "latest", engineVersion: "5.7.mysql_aurora.2.08.3", wantErr: true},
+		{name: "latest on the default", tag: "latest", engineVersion: DefaultMySQLEngineVersion},
+		{name: "latest on partial 5.7", tag: "latest", engineVersion: "5.7", wantErr: true},
+		{name: "unknown tag", tag: "nightly", engineVersion: "5.7.mysql_aurora.2.08.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckDatabaseCompatibility(tt.tag, AuroraMySQLEngine, tt.engineVersion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckDatabaseCompatibility(%q, %q, %q) = %v, want error %v",
+					tt.tag, AuroraMySQLEngine, tt.engineVersion, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package metabase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ecs"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type Architecture string
+
+const (
+	X86Architecture   Architecture = "x86_64"
+	ARM64Architecture Architecture = "arm64"
+)
+
+func ValidateArchitecture(architecture string) error {
+	switch Architecture(architecture) {
+	case X86Architecture, ARM64Architecture:
+		return nil
+	default:
+		return fmt.Errorf("compute.architecture must be one of %q or %q, got %q", X86Architecture, ARM64Architecture, architecture)
+	}
+}
+
+// CPUArchitecture returns the name ECS uses for the architecture in a task definition's runtime platform.
+func (a Architecture) CPUArchitecture() string {
+	if a == ARM64Architecture {
+		return "ARM64"
+	}
+	return "X86_64"
+}
+
+// imagePlatformArchitecture returns the name image manifests use for the architecture.
+func (a Architecture) imagePlatformArchitecture() string {
+	if a == ARM64Architecture {
+		return "arm64"
+	}
+	return "amd64"
+}
+
+type Capacity string
+
+const (
+	OnDemandCapacity Capacity = "on-demand"
+	SpotCapacity     Capacity = "spot"
+	MixedCapacity    Capacity = "mixed"
+)
+
+// Fargate gives a task two minutes' notice before Spot reclaims it, and two minutes is also the
+// longest ECS waits between SIGTERM and SIGKILL. Giving Metabase all of it lets running queries
+// finish and the JVM shut down cleanly.
+const SpotStopTimeout = 120
+
+func ValidateCapacity(capacity string) error {
+	switch Capacity(capacity) {
+	case OnDemandCapacity, SpotCapacity, MixedCapacity:
+		return nil
+	default:
+		return fmt.Errorf("compute.capacity must be one of %q, %q or %q, got %q", OnDemandCapacity, SpotCapacity, MixedCapacity, capacity)
+	}
+}
+
+// UsesSpot returns whether any of the tasks can be interrupted by Spot.
+func (c Capacity) UsesSpot() bool {
+	return c == SpotCapacity || c == MixedCapacity
+}
+
+// capacityProviderStrategies returns the service's capacity provider strategy. The mixed capacity keeps
+// the first task on regular Fargate so Metabase stays up, and runs most of any extra tasks on Spot.
+func (c Capacity) capacityProviderStrategies() ecs.ServiceCapacityProviderStrategyArray {
+	switch c {
+	case SpotCapacity:
+		return ecs.ServiceCapacityProviderStrategyArray{
+			ecs.ServiceCapacityProviderStrategyArgs{
+				CapacityProvider: pulumi.String("FARGATE_SPOT"),
+				Weight:           pulumi.IntPtr(1),
+			},
+		}
+	case MixedCapacity:
+		return ecs.ServiceCapacityProviderStrategyArray{
+			ecs.ServiceCapacityProviderStrategyArgs{
+				CapacityProvider: pulumi.String("FARGATE"),
+				Base:             pulumi.IntPtr(1),
+				Weight:           pulumi.IntPtr(1),
+			},
+			ecs.ServiceCapacityProviderStrategyArgs{
+				CapacityProvider: pulumi.String("FARGATE_SPOT"),
+				Weight:           pulumi.IntPtr(3),
+			},
+		}
+	default:
+		return ecs.ServiceCapacityProviderStrategyArray{
+			ecs.ServiceCapacityProviderStrategyArgs{
+				CapacityProvider: pulumi.String("FARGATE"),
+				Weight:           pulumi.IntPtr(1),
+			},
+		}
+	}
+}
+
+// imageManifest holds the fields of an image index, or of a single image manifest, needed to tell
+// which architectures the image is published for.
+type imageManifest struct {
+	Manifests []struct {
+		Platform struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"platform"`
+	} `json:"manifests"`
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+}
+
+// CheckImageArchitecture returns an error when the image isn't published for the architecture,
+// using the registry HTTP API. Only registries that allow anonymous pulls are supported.
+func CheckImageArchitecture(ctx context.Context, image string, architecture Architecture) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ref := ParseImageReference(image)
+	client := &registryClient{ref: ref}
+	resp, err := client.get(ctx, ref.manifestURL(), manifestMediaTypes)
+	if err != nil {
+		return fmt.Errorf("looking up the architectures of %q: %w", image, err)
+	}
+	defer resp.Body.Close()
+
+	var manifest imageManifest
+	if err := json.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return fmt.Errorf("looking up the architectures of %q: %w", image, err)
+	}
+
+	var architectures []string
+	if len(manifest.Manifests) > 0 {
+		for _, m := range manifest.Manifests {
+			// Image indexes can also list attestations, which have an `unknown` platform.
+			if m.Platform.OS == "linux" {
+				architectures = append(architectures, m.Platform.Architecture)
+			}
+		}
+	} else {
+		// A single image names its architecture in its config blob.
+		configURL := ref.registryURL("blobs", manifest.Config.Digest)
+		configResp, err := client.get(ctx, configURL, nil)
+		if err != nil {
+			return fmt.Errorf("looking up the architecture of %q: %w", image, err)
+		}
+		defer configResp.Body.Close()
+
+		var config struct {
+			Architecture string `json:"architecture"`
+		}
+		if err := json.NewDecoder(configResp.Body).Decode(&config); err != nil {
+			return fmt.Errorf("looking up the architecture of %q: %w", image, err)
+		}
+		architectures = append(architectures, config.Architecture)
+	}
+
+	for _, a := range architectures {
+		if a == architecture.imagePlatformArchitecture() {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q isn't published for compute.architecture %s, it's only available for %v", image, architecture, architectures)
+}
@@ -0,0 +1,29 @@
+package metabase
+
+import "fmt"
+
+type DatabaseEngine string
+
+const (
+	// Aurora Serverless MySQL, the default.
+	MySQLDatabaseEngine DatabaseEngine = "mysql"
+	// An H2 file on EFS, for sandboxes only. Metabase doesn't support H2 in production.
+	H2DatabaseEngine DatabaseEngine = "h2"
+)
+
+const (
+	// H2DataDir is where the volume holding the H2 database file is mounted.
+	H2DataDir        = "/metabase-data"
+	H2DataVolumeName = "metabase-data"
+	// H2DatabaseFile is the `MB_DB_FILE` path, H2 adds the `.mv.db` extension itself.
+	H2DatabaseFile = H2DataDir + "/metabase.db"
+)
+
+func ValidateDatabaseEngine(engine string) error {
+	switch DatabaseEngine(engine) {
+	case MySQLDatabaseEngine, H2DatabaseEngine:
+		return nil
+	default:
+		return fmt.Errorf("database.engine must be one of %q or %q, got %q", MySQLDatabaseEngine, H2DatabaseEngine, engine)
+	}
+}
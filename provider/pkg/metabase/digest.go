@@ -0,0 +1,253 @@
+package metabase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// The registry Docker uses for image references that don't name one.
+const dockerHubRegistry = "registry-1.docker.io"
+
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+// registryHTTPClient makes the requests to registries, tests replace it to talk to local registries.
+var registryHTTPClient = http.DefaultClient
+
+var bearerChallengeParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+var ecrRegistryPattern = regexp.MustCompile(`^(\d{12})\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com(\.cn)?$`)
+
+// ImageReference is a parsed `registry/repository:tag` or `registry/repository@digest` image reference.
+type ImageReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func ParseImageReference(image string) ImageReference {
+	ref := ImageReference{Registry: dockerHubRegistry, Tag: "latest"}
+
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, ref.Digest = image[:i], image[i+1:]
+	}
+	// A colon after the last slash separates the tag, any other colon is part of the registry's port.
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image, ref.Tag = image[:i], image[i+1:]
+	}
+
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		ref.Registry, image = parts[0], parts[1]
+	}
+	if ref.Registry == dockerHubRegistry && !strings.Contains(image, "/") {
+		image = "library/" + image
+	}
+	ref.Repository = image
+
+	return ref
+}
+
+// ResolveImageDigest looks up the digest the image's tag currently points to using the registry
+// HTTP API. Only registries that allow anonymous pulls are supported, ECR images are looked up
+// with the ECR API instead.
+func ResolveImageDigest(ctx context.Context, image string) (string, error) {
+	ref := ParseImageReference(image)
+	if ref.Digest != "" {
+		return ref.Digest, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// A HEAD request doesn't count against Docker Hub's pull rate limit.
+	client := &registryClient{ref: ref}
+	resp, err := client.head(ctx, ref.manifestURL(), manifestMediaTypes)
+	if err != nil {
+		return "", fmt.Errorf("resolving the digest of %q: %w", image, err)
+	}
+	resp.Body.Close()
+
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+
+	// Not every registry returns the digest header, in which case it's the hash of the manifest.
+	resp, err = client.get(ctx, ref.manifestURL(), manifestMediaTypes)
+	if err != nil {
+		return "", fmt.Errorf("resolving the digest of %q: %w", image, err)
+	}
+	defer resp.Body.Close()
+
+	manifest, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(manifest)
+	return "sha256:" + hex.EncodeToString(hash[:]), nil
+}
+
+// ShortDigest returns the first 12 hexadecimal characters of a `sha256` digest, which is how Docker
+// abbreviates image IDs.
+func ShortDigest(digest string) string {
+	hash := strings.TrimPrefix(digest, "sha256:")
+	if len(hash) > 12 {
+		hash = hash[:12]
+	}
+	return hash
+}
+
+// ECRRegistry returns the account and region of the image's registry when it's a private ECR
+// registry, which only takes IAM credentials.
+func (ref ImageReference) ECRRegistry() (registryID string, region string, ok bool) {
+	match := ecrRegistryPattern.FindStringSubmatch(ref.Registry)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
+
+func (ref ImageReference) manifestURL() string {
+	reference := ref.Tag
+	if ref.Digest != "" {
+		reference = ref.Digest
+	}
+	return ref.registryURL("manifests", reference)
+}
+
+// registryURL returns the registry HTTP API URL of a manifest, blob or upload of the repository.
+func (ref ImageReference) registryURL(kind string, reference string) string {
+	return fmt.Sprintf("https://%s/v2/%s/%s/%s", ref.Registry, ref.Repository, kind, reference)
+}
+
+// registryClient makes anonymous requests to a repository of a registry, fetching a pull token
+// the first time the registry asks for one. Registries that take credentials, such as ECR, are
+// sent the authorization header instead.
+type registryClient struct {
+	ref           ImageReference
+	token         string
+	authorization string
+}
+
+// get requests the URL and returns the response when the registry answered 200.
+func (c *registryClient) get(ctx context.Context, requestURL string, accept []string) (*http.Response, error) {
+	return c.fetch(ctx, http.MethodGet, requestURL, accept)
+}
+
+// head is like get but only returns the headers of the response.
+func (c *registryClient) head(ctx context.Context, requestURL string, accept []string) (*http.Response, error) {
+	return c.fetch(ctx, http.MethodHead, requestURL, accept)
+}
+
+func (c *registryClient) fetch(ctx context.Context, method string, requestURL string, accept []string) (*http.Response, error) {
+	resp, err := c.do(ctx, method, requestURL, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && c.token == "" && c.authorization == "" {
+		resp.Body.Close()
+		c.token, err = registryToken(ctx, resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return nil, fmt.Errorf("authenticating with %s: %w", c.ref.Registry, err)
+		}
+		resp, err = c.do(ctx, method, requestURL, accept)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s returned %s", c.ref.Registry, resp.Status)
+	}
+	return resp, nil
+}
+
+func (c *registryClient) do(ctx context.Context, method string, requestURL string, accept []string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	return registryHTTPClient.Do(req)
+}
+
+func (c *registryClient) newRequest(ctx context.Context, method string, requestURL string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case c.authorization != "":
+		req.Header.Set("Authorization", c.authorization)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	return req, nil
+}
+
+// registryToken requests an anonymous pull token from the realm named in the registry's bearer challenge.
+func registryToken(ctx context.Context, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	params := map[string]string{}
+	for _, match := range bearerChallengeParam.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+	realm, ok := params["realm"]
+	if !ok {
+		return "", fmt.Errorf("authentication challenge %q has no realm", challenge)
+	}
+
+	query := url.Values{}
+	if service, ok := params["service"]; ok {
+		query.Set("service", service)
+	}
+	if scope, ok := params["scope"]; ok {
+		query.Set("scope", scope)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := registryHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s", realm, resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", err
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	return body.AccessToken, nil
+}
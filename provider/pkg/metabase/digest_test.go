@@ -0,0 +1,158 @@
+package metabase
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseImageReference(t *testing.T) {
+	tests := []struct {
+		image string
+		ref   ImageReference
+	}{
+		{
+			image: "metabase/metabase",
+			ref:   ImageReference{Registry: dockerHubRegistry, Repository: "metabase/metabase", Tag: "latest"},
+		},
+		{
+			image: "metabase/metabase:v0.47.0",
+			ref:   ImageReference{Registry: dockerHubRegistry, Repository: "metabase/metabase", Tag: "v0.47.0"},
+		},
+		{
+			image: "alpine:3",
+			ref:   ImageReference{Registry: dockerHubRegistry, Repository: "library/alpine", Tag: "3"},
+		},
+		{
+			image: "metabase/metabase@sha256:abc",
+			ref:   ImageReference{Registry: dockerHubRegistry, Repository: "metabase/metabase", Tag: "latest", Digest: "sha256:abc"},
+		},
+		{
+			image: "metabase/metabase:v0.47.0@sha256:abc",
+			ref:   ImageReference{Registry: dockerHubRegistry, Repository: "metabase/metabase", Tag: "v0.47.0", Digest: "sha256:abc"},
+		},
+		{
+			image: "public.ecr.aws/docker/library/alpine:3",
+			ref:   ImageReference{Registry: "public.ecr.aws", Repository: "docker/library/alpine", Tag: "3"},
+		},
+		{
+			image: "localhost:5000/metabase:v0.47.0",
+			ref:   ImageReference{Registry: "localhost:5000", Repository: "metabase", Tag: "v0.47.0"},
+		},
+		{
+			image: "localhost/metabase",
+			ref:   ImageReference{Registry: "localhost", Repository: "metabase", Tag: "latest"},
+		},
+		{
+			image: "123456789012.dkr.ecr.eu-west-1.amazonaws.com/metabase:v0.47.0",
+			ref:   ImageReference{Registry: "123456789012.dkr.ecr.eu-west-1.amazonaws.com", Repository: "metabase", Tag: "v0.47.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if ref := ParseImageReference(tt.image); ref != tt.ref {
+				t.Errorf("ParseImageReference(%q) = %+v, want %+v", tt.image, ref, tt.ref)
+			}
+		})
+	}
+}
+
+func TestECRRegistry(t *testing.T) {
+	tests := []struct {
+		image      string
+		registryID string
+		region     string
+		ok         bool
+	}{
+		{image: "123456789012.dkr.ecr.eu-west-1.amazonaws.com/metabase", registryID: "123456789012", region: "eu-west-1", ok: true},
+		{image: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/metabase", registryID: "123456789012", region: "cn-north-1", ok: true},
+		{image: "public.ecr.aws/docker/library/alpine"},
+		{image: "metabase/metabase"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			registryID, region, ok := ParseImageReference(tt.image).ECRRegistry()
+			if registryID != tt.registryID || region != tt.region || ok != tt.ok {
+				t.Errorf("ECRRegistry() of %q = %q, %q, %v, want %q, %q, %v",
+					tt.image, registryID, region, ok, tt.registryID, tt.region, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRegistryToken(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/token":
+			if query.Get("service") != "registry.test" || query.Get("scope") != "repository:metabase/metabase:pull" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token": "pull-token"}`))
+		case "/oauth":
+			_, _ = w.Write([]byte(`{"access_token": "access-token"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+	useRegistryClient(t, server)
+
+	tests := []struct {
+		name      string
+		challenge string
+		token     string
+		wantErr   bool
+	}{
+		{
+			name:      "token",
+			challenge: `Bearer realm="` + server.URL + `/token",service="registry.test",scope="repository:metabase/metabase:pull"`,
+			token:     "pull-token",
+		},
+		{
+			name:      "access token",
+			challenge: `Bearer realm="` + server.URL + `/oauth"`,
+			token:     "access-token",
+		},
+		{
+			name:      "denied",
+			challenge: `Bearer realm="` + server.URL + `/denied"`,
+			wantErr:   true,
+		},
+		{
+			name:      "no realm",
+			challenge: `Bearer service="registry.test"`,
+			wantErr:   true,
+		},
+		{
+			name:      "basic challenge",
+			challenge: `Basic realm="` + server.URL + `/token"`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := registryToken(context.Background(), tt.challenge)
+			if (err != nil) != tt.wantErr || token != tt.token {
+				t.Errorf("registryToken(%q) = %q, %v, want %q, error %v", tt.challenge, token, err, tt.token, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveImageDigest(t *testing.T) {
+	registry := newTestRegistry(t, "")
+	digest := registry.putManifest("metabase/metabase", "v0.47.0", `{"mediaType": "application/vnd.oci.image.manifest.v1+json"}`)
+	image := strings.TrimPrefix(registry.server.URL, "https://") + "/metabase/metabase:v0.47.0"
+
+	resolved, err := ResolveImageDigest(context.Background(), image)
+	if err != nil || resolved != digest {
+		t.Errorf("ResolveImageDigest(%q) = %q, %v, want %q", image, resolved, err, digest)
+	}
+}
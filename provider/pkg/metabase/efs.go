@@ -0,0 +1,96 @@
+package metabase
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ecs"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/efs"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The uid and gid of the `metabase` user in the official images, files on EFS are owned by it.
+const metabaseUID = 2000
+
+// EFSVolume is a directory of the component's EFS file system mounted into the task.
+type EFSVolume struct {
+	Name          string
+	FileSystemID  pulumi.IDOutput
+	AccessPointID pulumi.IDOutput
+}
+
+func (v EFSVolume) taskDefinitionVolume() ecs.TaskDefinitionVolumeArgs {
+	return ecs.TaskDefinitionVolumeArgs{
+		Name: pulumi.String(v.Name),
+		EfsVolumeConfiguration: &ecs.TaskDefinitionVolumeEfsVolumeConfigurationArgs{
+			FileSystemId:      v.FileSystemID,
+			TransitEncryption: pulumi.StringPtr("ENABLED"),
+			AuthorizationConfig: &ecs.TaskDefinitionVolumeEfsVolumeConfigurationAuthorizationConfigArgs{
+				AccessPointId: v.AccessPointID.ToStringOutput().ToStringPtrOutput(),
+			},
+		},
+	}
+}
+
+// FileSystem is an EFS file system reachable from the Metabase task.
+type FileSystem struct {
+	FileSystem *efs.FileSystem
+	// The mount targets have to exist before a task can mount the file system.
+	MountTargets []pulumi.Resource
+}
+
+// NewFileSystem creates an encrypted EFS file system with a mount target in each of the subnets the
+// task runs in. EFS allows a single mount target per availability zone, so the subnets must be in
+// different zones. The Metabase security group allows NFS between its members.
+func (m *MetabaseResourceConstructor) NewFileSystem(subnetIDs pulumi.StringArrayInput, subnetCount int, securityGroupID pulumi.IDOutput) (*FileSystem, error) {
+	fileSystem, err := efs.NewFileSystem(m.ctx, m.baseResourceName, &efs.FileSystemArgs{
+		Encrypted: pulumi.BoolPtr(true),
+	}, m.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FileSystem{FileSystem: fileSystem}
+	for i := 0; i < subnetCount; i++ {
+		mountTargetName := fmt.Sprintf("%s-%d", m.baseResourceName, i)
+		mountTarget, err := efs.NewMountTarget(m.ctx, mountTargetName, &efs.MountTargetArgs{
+			FileSystemId:   fileSystem.ID(),
+			SubnetId:       subnetIDs.ToStringArrayOutput().Index(pulumi.Int(i)),
+			SecurityGroups: pulumi.StringArray{securityGroupID.ToStringOutput()},
+		}, m.opts...)
+		if err != nil {
+			return nil, err
+		}
+		result.MountTargets = append(result.MountTargets, mountTarget)
+	}
+	return result, nil
+}
+
+// NewVolume creates an access point rooted at the directory, owned by the `metabase` user, and
+// returns the volume that mounts it into the task.
+func (m *MetabaseResourceConstructor) NewVolume(fileSystem *FileSystem, name, directory string) (EFSVolume, error) {
+	accessPointName := fmt.Sprintf("%s-%s", m.baseResourceName, name)
+	accessPoint, err := efs.NewAccessPoint(m.ctx, accessPointName, &efs.AccessPointArgs{
+		FileSystemId: fileSystem.FileSystem.ID(),
+		PosixUser: &efs.AccessPointPosixUserArgs{
+			Uid: pulumi.Int(metabaseUID),
+			Gid: pulumi.Int(metabaseUID),
+		},
+		RootDirectory: &efs.AccessPointRootDirectoryArgs{
+			Path: pulumi.String(directory),
+			CreationInfo: &efs.AccessPointRootDirectoryCreationInfoArgs{
+				OwnerUid:    pulumi.Int(metabaseUID),
+				OwnerGid:    pulumi.Int(metabaseUID),
+				Permissions: pulumi.String("755"),
+			},
+		},
+	}, m.opts...)
+	if err != nil {
+		return EFSVolume{}, err
+	}
+
+	return EFSVolume{
+		Name:          name,
+		FileSystemID:  fileSystem.FileSystem.ID(),
+		AccessPointID: accessPoint.ID(),
+	}, nil
+}
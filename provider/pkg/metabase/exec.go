@@ -0,0 +1,101 @@
+package metabase
+
+import (
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ecs"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// ExecuteCommand configures ECS Exec sessions into the Metabase container. The session output is
+// logged to the CloudWatch log group and/or the S3 bucket when they're set.
+type ExecuteCommand struct {
+	KMSKeyID     pulumi.StringInput
+	LogGroupName pulumi.StringInput
+	S3BucketName pulumi.StringInput
+	S3KeyPrefix  pulumi.StringInput
+}
+
+func (e ExecuteCommand) logsSessions() bool {
+	return e.LogGroupName != nil || e.S3BucketName != nil
+}
+
+// clusterConfiguration returns the cluster's `executeCommandConfiguration`. Sessions are logged with
+// the awslogs defaults of the task unless a log group or bucket is set.
+func (e ExecuteCommand) clusterConfiguration() *ecs.ClusterConfigurationArgs {
+	config := &ecs.ClusterConfigurationExecuteCommandConfigurationArgs{
+		KmsKeyId: stringPtr(e.KMSKeyID),
+		Logging:  pulumi.String("DEFAULT"),
+	}
+	if e.logsSessions() {
+		config.Logging = pulumi.String("OVERRIDE")
+		config.LogConfiguration = &ecs.ClusterConfigurationExecuteCommandConfigurationLogConfigurationArgs{
+			CloudWatchLogGroupName: stringPtr(e.LogGroupName),
+			S3BucketName:           stringPtr(e.S3BucketName),
+			S3KeyPrefix:            stringPtr(e.S3KeyPrefix),
+		}
+	}
+	return &ecs.ClusterConfigurationArgs{ExecuteCommandConfiguration: config}
+}
+
+// executeCommandPolicy lets the SSM agent in the task open the session channels and write the
+// session logs in the partition of the account.
+func (m *MetabaseResourceConstructor) executeCommandPolicy(e ExecuteCommand, partition string) pulumi.StringOutput {
+	statements := iam.GetPolicyDocumentStatementArray{
+		iam.GetPolicyDocumentStatementArgs{
+			Actions: pulumi.ToStringArray([]string{
+				"ssmmessages:CreateControlChannel",
+				"ssmmessages:CreateDataChannel",
+				"ssmmessages:OpenControlChannel",
+				"ssmmessages:OpenDataChannel",
+			}),
+			Resources: pulumi.ToStringArray([]string{"*"}),
+		},
+	}
+	if e.KMSKeyID != nil {
+		statements = append(statements, iam.GetPolicyDocumentStatementArgs{
+			Actions:   pulumi.ToStringArray([]string{"kms:Decrypt"}),
+			Resources: pulumi.StringArray{e.KMSKeyID},
+		})
+	}
+	if e.LogGroupName != nil {
+		statements = append(statements,
+			iam.GetPolicyDocumentStatementArgs{
+				Actions:   pulumi.ToStringArray([]string{"logs:DescribeLogGroups"}),
+				Resources: pulumi.ToStringArray([]string{"*"}),
+			},
+			iam.GetPolicyDocumentStatementArgs{
+				Actions: pulumi.ToStringArray([]string{
+					"logs:CreateLogStream",
+					"logs:DescribeLogStreams",
+					"logs:PutLogEvents",
+				}),
+				Resources: pulumi.StringArray{
+					pulumi.Sprintf("arn:%s:logs:*:*:log-group:%s:*", partition, e.LogGroupName),
+				},
+			},
+		)
+	}
+	if e.S3BucketName != nil {
+		statements = append(statements,
+			iam.GetPolicyDocumentStatementArgs{
+				Actions:   pulumi.ToStringArray([]string{"s3:GetEncryptionConfiguration"}),
+				Resources: pulumi.StringArray{pulumi.Sprintf("arn:%s:s3:::%s", partition, e.S3BucketName)},
+			},
+			iam.GetPolicyDocumentStatementArgs{
+				Actions:   pulumi.ToStringArray([]string{"s3:PutObject"}),
+				Resources: pulumi.StringArray{pulumi.Sprintf("arn:%s:s3:::%s/*", partition, e.S3BucketName)},
+			},
+		)
+	}
+
+	return iam.GetPolicyDocumentOutput(m.ctx, iam.GetPolicyDocumentOutputArgs{
+		Statements: statements,
+	}).Json()
+}
+
+func stringPtr(input pulumi.StringInput) pulumi.StringPtrInput {
+	if input == nil {
+		return nil
+	}
+	return input.ToStringOutput().ToStringPtrOutput()
+}
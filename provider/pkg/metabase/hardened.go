@@ -0,0 +1,44 @@
+package metabase
+
+import "fmt"
+
+const (
+	// Fargate doesn't support tmpfs mounts, so the paths Metabase writes to are mounted from EFS when
+	// the root filesystem is read-only. The JVM and Metabase write their temporary files to TmpDir.
+	TmpDir        = "/tmp"
+	TmpVolumeName = "tmp"
+
+	// HardenedStopTimeout gives Metabase time to finish in-flight requests and close its database
+	// connections. Fargate allows at most 120 seconds.
+	HardenedStopTimeout = 60
+
+	// The JVM keeps a file descriptor open for every JAR, socket and log file.
+	hardenedOpenFilesLimit = 65536
+)
+
+// TmpMountPoint mounts the temporary files volume into a container.
+func TmpMountPoint() map[string]interface{} {
+	return map[string]interface{}{
+		"sourceVolume":  TmpVolumeName,
+		"containerPath": TmpDir,
+	}
+}
+
+// HardenContainer runs the container as the `metabase` user of the official images with a
+// read-only root filesystem, no Linux capabilities and an open files limit that fits the JVM.
+func HardenContainer(container map[string]interface{}) {
+	container["user"] = fmt.Sprintf("%d:%d", metabaseUID, metabaseUID)
+	container["readonlyRootFilesystem"] = true
+	container["linuxParameters"] = map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"drop": []string{"ALL"},
+		},
+	}
+	container["ulimits"] = []map[string]interface{}{
+		{
+			"name":      "nofile",
+			"softLimit": hardenedOpenFilesLimit,
+			"hardLimit": hardenedOpenFilesLimit,
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package metabase
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/lb"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Metabase only starts answering 200 on this path once the application database
+// migrations have finished, unlike `/` which is served as soon as Jetty is up.
+const HealthCheckPath = "/api/health"
+
+type HealthCheck struct {
+	Interval           int
+	Timeout            int
+	HealthyThreshold   int
+	UnhealthyThreshold int
+	StartPeriod        int
+}
+
+func DefaultHealthCheck() HealthCheck {
+	return HealthCheck{
+		Interval:           30,
+		Timeout:            5,
+		HealthyThreshold:   2,
+		UnhealthyThreshold: 5,
+		// Metabase can take several minutes to run its migrations on the first start or
+		// after an upgrade, 300 seconds is the longest start period ECS allows.
+		StartPeriod: 300,
+	}
+}
+
+// Validate checks the health check against the limits shared by the ALB target
+// group and the ECS container health check.
+func (h HealthCheck) Validate() error {
+	if h.Interval < 5 || h.Interval > 300 {
+		return fmt.Errorf("healthCheck.interval must be between 5 and 300 seconds, got %d", h.Interval)
+	}
+	if h.Timeout < 2 || h.Timeout > 60 {
+		return fmt.Errorf("healthCheck.timeout must be between 2 and 60 seconds, got %d", h.Timeout)
+	}
+	if h.Timeout >= h.Interval {
+		return fmt.Errorf("healthCheck.timeout (%d) must be less than healthCheck.interval (%d)", h.Timeout, h.Interval)
+	}
+	if h.HealthyThreshold < 2 || h.HealthyThreshold > 10 {
+		return fmt.Errorf("healthCheck.healthyThreshold must be between 2 and 10, got %d", h.HealthyThreshold)
+	}
+	if h.UnhealthyThreshold < 2 || h.UnhealthyThreshold > 10 {
+		return fmt.Errorf("healthCheck.unhealthyThreshold must be between 2 and 10, got %d", h.UnhealthyThreshold)
+	}
+	if h.StartPeriod < 0 || h.StartPeriod > 300 {
+		return fmt.Errorf("healthCheck.startPeriod must be between 0 and 300 seconds, got %d", h.StartPeriod)
+	}
+	return nil
+}
+
+func (h HealthCheck) targetGroupHealthCheck() *lb.TargetGroupHealthCheckArgs {
+	return &lb.TargetGroupHealthCheckArgs{
+		Enabled:            pulumi.BoolPtr(true),
+		Path:               pulumi.String(HealthCheckPath),
+		Matcher:            pulumi.String("200"),
+		Protocol:           pulumi.String("HTTP"),
+		Interval:           pulumi.IntPtr(h.Interval),
+		Timeout:            pulumi.IntPtr(h.Timeout),
+		HealthyThreshold:   pulumi.IntPtr(h.HealthyThreshold),
+		UnhealthyThreshold: pulumi.IntPtr(h.UnhealthyThreshold),
+	}
+}
+
+// ContainerHealthCheck returns the `healthCheck` block of an ECS container definition. The
+// `metabase/metabase` image ships with curl so we can hit the health endpoint from inside the task.
+func (h HealthCheck) ContainerHealthCheck(port int) map[string]interface{} {
+	return map[string]interface{}{
+		"command": []string{
+			"CMD-SHELL",
+			fmt.Sprintf("curl --fail --silent http://localhost:%d%s || exit 1", port, HealthCheckPath),
+		},
+		"interval":    h.Interval,
+		"timeout":     h.Timeout,
+		"retries":     h.UnhealthyThreshold,
+		"startPeriod": h.StartPeriod,
+	}
+}
@@ -0,0 +1,273 @@
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/cloudformation"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type ImageMirrorType string
+
+const (
+	// Create a private ECR repository and copy the Metabase image to it.
+	RepositoryMirror ImageMirrorType = "repository"
+)
+
+// Copying the layers of the Metabase image takes a few minutes on a slow connection.
+const imageCopyTimeout = 30 * time.Minute
+
+func ValidateImageMirror(mirrorType string) error {
+	switch ImageMirrorType(mirrorType) {
+	case RepositoryMirror:
+		return nil
+	default:
+		return fmt.Errorf("imageMirror.type must be %q, got %q", RepositoryMirror, mirrorType)
+	}
+}
+
+// ImageRepository is the private ECR repository holding the mirror of the Metabase image.
+type ImageRepository struct {
+	RegistryID    pulumi.StringOutput
+	RepositoryURL pulumi.StringOutput
+}
+
+// NewImageRepository creates a private ECR repository to hold a mirror of the Metabase image. ECR
+// can't delete a repository that still holds images and the AWS provider can't force it, so the
+// repository is declared in a CloudFormation stack, which empties it before deleting it.
+func (m *MetabaseResourceConstructor) NewImageRepository(scanOnPush bool) (*ImageRepository, error) {
+	template, err := json.Marshal(map[string]interface{}{
+		"Resources": map[string]interface{}{
+			"Repository": map[string]interface{}{
+				"Type": "AWS::ECR::Repository",
+				"Properties": map[string]interface{}{
+					"EmptyOnDelete": true,
+					"ImageScanningConfiguration": map[string]interface{}{
+						"ScanOnPush": scanOnPush,
+					},
+				},
+			},
+		},
+		"Outputs": map[string]interface{}{
+			"RegistryId": map[string]interface{}{
+				"Value": map[string]interface{}{"Ref": "AWS::AccountId"},
+			},
+			"RepositoryUri": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": []string{"Repository", "RepositoryUri"}},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stack, err := cloudformation.NewStack(m.ctx, fmt.Sprintf("%s-imageRepository", m.baseResourceName), &cloudformation.StackArgs{
+		TemplateBody: pulumi.String(template),
+	}, m.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageRepository{
+		RegistryID:    stack.Outputs.MapIndex(pulumi.String("RegistryId")),
+		RepositoryURL: stack.Outputs.MapIndex(pulumi.String("RepositoryUri")),
+	}, nil
+}
+
+// mirroredManifest holds the fields of an image index, or of a single image manifest, naming the
+// manifests and blobs it's made of.
+type mirroredManifest struct {
+	MediaType string               `json:"mediaType"`
+	Manifests []manifestDescriptor `json:"manifests"`
+	Config    *manifestDescriptor  `json:"config"`
+	Layers    []manifestDescriptor `json:"layers"`
+}
+
+type manifestDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+// imageCopy copies an image between the repositories of two registries with the registry HTTP API.
+type imageCopy struct {
+	source      *registryClient
+	destination *registryClient
+}
+
+// CopyImage copies an image, with every platform of a multi-platform image, from a registry that
+// allows anonymous pulls to a private ECR repository and tags it there. The ECR authorization
+// token is the base64 encoded `user:password` returned by ECR. Manifests are copied byte for byte
+// so the copy keeps the digest of the source image, and blobs already in the destination aren't
+// copied again.
+func CopyImage(ctx context.Context, source string, destination string, authorizationToken string) error {
+	ctx, cancel := context.WithTimeout(ctx, imageCopyTimeout)
+	defer cancel()
+
+	sourceRef := ParseImageReference(source)
+	destinationRef := ParseImageReference(destination)
+	c := &imageCopy{
+		source:      &registryClient{ref: sourceRef},
+		destination: &registryClient{ref: destinationRef, authorization: "Basic " + authorizationToken},
+	}
+
+	sourceReference := sourceRef.Tag
+	if sourceRef.Digest != "" {
+		sourceReference = sourceRef.Digest
+	}
+	if err := c.copyManifest(ctx, sourceReference, destinationRef.Tag); err != nil {
+		return fmt.Errorf("copying %q to %q: %w", source, destination, err)
+	}
+	return nil
+}
+
+// copyManifest copies the manifest, and the manifests and blobs it references, and stores it under
+// the destination reference. Manifests looked up by digest that are already in the destination
+// under the reference are skipped.
+func (c *imageCopy) copyManifest(ctx context.Context, sourceReference string, destinationReference string) error {
+	if strings.HasPrefix(sourceReference, "sha256:") {
+		copied, err := c.hasManifest(ctx, destinationReference, sourceReference)
+		if err != nil || copied {
+			return err
+		}
+	}
+
+	resp, err := c.source.get(ctx, c.source.ref.registryURL("manifests", sourceReference), manifestMediaTypes)
+	if err != nil {
+		return err
+	}
+	manifest, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	var contents mirroredManifest
+	if err := json.Unmarshal(manifest, &contents); err != nil {
+		return err
+	}
+	mediaType := resp.Header.Get("Content-Type")
+	if contents.MediaType != "" {
+		mediaType = contents.MediaType
+	}
+
+	// An index is stored after the images it lists, and an image after its blobs.
+	for _, m := range contents.Manifests {
+		if err := c.copyManifest(ctx, m.Digest, m.Digest); err != nil {
+			return err
+		}
+	}
+	blobs := contents.Layers
+	if contents.Config != nil {
+		blobs = append(blobs, *contents.Config)
+	}
+	for _, blob := range blobs {
+		if err := c.copyBlob(ctx, blob); err != nil {
+			return err
+		}
+	}
+
+	req, err := c.destination.newRequest(ctx, http.MethodPut, c.destination.ref.registryURL("manifests", destinationReference), bytes.NewReader(manifest))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", mediaType)
+	_, err = c.send(req, http.StatusCreated)
+	return err
+}
+
+// hasManifest tells whether the destination stores the manifest with the digest under the reference.
+func (c *imageCopy) hasManifest(ctx context.Context, reference string, digest string) (bool, error) {
+	resp, err := c.destination.do(ctx, http.MethodHead, c.destination.ref.registryURL("manifests", reference), manifestMediaTypes)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Header.Get("Docker-Content-Digest") == digest, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%s returned %s", c.destination.ref.Registry, resp.Status)
+	}
+}
+
+// copyBlob streams a blob missing from the destination to it in a single chunk.
+func (c *imageCopy) copyBlob(ctx context.Context, blob manifestDescriptor) error {
+	resp, err := c.destination.do(ctx, http.MethodHead, c.destination.ref.registryURL("blobs", blob.Digest), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+	default:
+		return fmt.Errorf("%s returned %s", c.destination.ref.Registry, resp.Status)
+	}
+
+	source, err := c.source.get(ctx, c.source.ref.registryURL("blobs", blob.Digest), nil)
+	if err != nil {
+		return err
+	}
+	defer source.Body.Close()
+
+	// Start an upload, send the whole blob and complete the upload with the blob's digest.
+	req, err := c.destination.newRequest(ctx, http.MethodPost, c.destination.ref.registryURL("blobs", "uploads/"), nil)
+	if err != nil {
+		return err
+	}
+	location, err := c.send(req, http.StatusAccepted)
+	if err != nil {
+		return err
+	}
+
+	req, err = c.destination.newRequest(ctx, http.MethodPatch, location, source.Body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = blob.Size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	location, err = c.send(req, http.StatusAccepted)
+	if err != nil {
+		return err
+	}
+
+	req, err = c.destination.newRequest(ctx, http.MethodPut, location, nil)
+	if err != nil {
+		return err
+	}
+	query := req.URL.Query()
+	query.Set("digest", blob.Digest)
+	req.URL.RawQuery = query.Encode()
+	_, err = c.send(req, http.StatusCreated)
+	return err
+}
+
+// send makes a request to the destination and returns the absolute URL of the response's
+// location, which is where an upload continues.
+func (c *imageCopy) send(req *http.Request, expectedStatus int) (string, error) {
+	resp, err := registryHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		return "", fmt.Errorf("%s returned %s", c.destination.ref.Registry, resp.Status)
+	}
+	location, err := req.URL.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return "", err
+	}
+	return location.String(), nil
+}
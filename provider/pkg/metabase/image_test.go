@@ -0,0 +1,290 @@
+package metabase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testManifest struct {
+	mediaType string
+	body      []byte
+}
+
+// testRegistry is an in-memory registry serving the parts of the registry HTTP API the component
+// uses. Without credentials it hands out anonymous pull tokens like Docker Hub, otherwise it takes
+// the basic authorization like ECR.
+type testRegistry struct {
+	server      *httptest.Server
+	credentials string
+
+	mu        sync.Mutex
+	manifests map[string]testManifest
+	blobs     map[string][]byte
+	uploads   map[string][]byte
+}
+
+func newTestRegistry(t *testing.T, credentials string) *testRegistry {
+	r := &testRegistry{
+		credentials: credentials,
+		manifests:   map[string]testManifest{},
+		blobs:       map[string][]byte{},
+		uploads:     map[string][]byte{},
+	}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.server.Close)
+	useRegistryClient(t, r.server)
+	return r
+}
+
+// useRegistryClient sends the registry requests of the test to the TLS test servers.
+func useRegistryClient(t *testing.T, servers ...*httptest.Server) {
+	roots := x509.NewCertPool()
+	for _, server := range servers {
+		roots.AddCert(server.Certificate())
+	}
+	client := registryHTTPClient
+	registryHTTPClient = &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}}}
+	t.Cleanup(func() { registryHTTPClient = client })
+}
+
+func (r *testRegistry) host() string {
+	return strings.TrimPrefix(r.server.URL, "https://")
+}
+
+func testDigest(content []byte) string {
+	hash := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+// putManifest stores the manifest under its digest, and under the tag when there's one.
+func (r *testRegistry) putManifest(repository string, tag string, body string) string {
+	var contents mirroredManifest
+	_ = json.Unmarshal([]byte(body), &contents)
+	digest := testDigest([]byte(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	manifest := testManifest{mediaType: contents.MediaType, body: []byte(body)}
+	r.manifests[repository+"@"+digest] = manifest
+	if tag != "" {
+		r.manifests[repository+":"+tag] = manifest
+	}
+	return digest
+}
+
+func (r *testRegistry) putBlob(content string) manifestDescriptor {
+	digest := testDigest([]byte(content))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blobs[digest] = []byte(content)
+	return manifestDescriptor{MediaType: "application/octet-stream", Digest: digest, Size: int64(len(content))}
+}
+
+func (r *testRegistry) manifest(repository string, reference string) (testManifest, bool) {
+	separator := ":"
+	if strings.HasPrefix(reference, "sha256:") {
+		separator = "@"
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	manifest, ok := r.manifests[repository+separator+reference]
+	return manifest, ok
+}
+
+func (r *testRegistry) uploadCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.uploads)
+}
+
+func (r *testRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case r.credentials != "":
+		if req.Header.Get("Authorization") != "Basic "+r.credentials {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	case req.URL.Path == "/token":
+		_, _ = w.Write([]byte(`{"token": "pull-token"}`))
+		return
+	case req.Header.Get("Authorization") != "Bearer pull-token":
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, r.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	if i := strings.Index(path, "/manifests/"); i >= 0 {
+		r.serveManifest(w, req, path[:i], path[i+len("/manifests/"):])
+		return
+	}
+	if i := strings.Index(path, "/blobs/"); i >= 0 {
+		r.serveBlob(w, req, path[:i], path[i+len("/blobs/"):])
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (r *testRegistry) serveManifest(w http.ResponseWriter, req *http.Request, repository string, reference string) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		manifest, ok := r.manifest(repository, reference)
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", manifest.mediaType)
+		w.Header().Set("Docker-Content-Digest", testDigest(manifest.body))
+		_, _ = w.Write(manifest.body)
+	case http.MethodPut:
+		body, _ := io.ReadAll(req.Body)
+		var contents mirroredManifest
+		if json.Unmarshal(body, &contents) != nil || req.Header.Get("Content-Type") != contents.MediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		tag := reference
+		if strings.HasPrefix(reference, "sha256:") {
+			tag = ""
+		}
+		r.putManifest(repository, tag, string(body))
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (r *testRegistry) serveBlob(w http.ResponseWriter, req *http.Request, repository string, reference string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case reference == "uploads/" && req.Method == http.MethodPost:
+		id := fmt.Sprintf("%d", len(r.uploads))
+		r.uploads[id] = nil
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%s", repository, id))
+		w.WriteHeader(http.StatusAccepted)
+	case strings.HasPrefix(reference, "uploads/") && req.Method == http.MethodPatch:
+		id := strings.TrimPrefix(reference, "uploads/")
+		body, _ := io.ReadAll(req.Body)
+		r.uploads[id] = append(r.uploads[id], body...)
+		w.Header().Set("Location", req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	case strings.HasPrefix(reference, "uploads/") && req.Method == http.MethodPut:
+		content := r.uploads[strings.TrimPrefix(reference, "uploads/")]
+		digest := req.URL.Query().Get("digest")
+		if testDigest(content) != digest {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.blobs[digest] = content
+		w.WriteHeader(http.StatusCreated)
+	case req.Method == http.MethodGet || req.Method == http.MethodHead:
+		content, ok := r.blobs[reference]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(content)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestCopyImage(t *testing.T) {
+	source := newTestRegistry(t, "")
+	destination := newTestRegistry(t, "QVdTOnRva2Vu")
+	useRegistryClient(t, source.server, destination.server)
+
+	config := source.putBlob(`{"architecture": "amd64", "os": "linux"}`)
+	layer := source.putBlob("layer")
+	image, _ := json.Marshal(mirroredManifest{
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Config:    &config,
+		Layers:    []manifestDescriptor{layer},
+	})
+	imageDigest := source.putManifest("metabase/metabase", "", string(image))
+	index, _ := json.Marshal(mirroredManifest{
+		MediaType: "application/vnd.oci.image.index.v1+json",
+		Manifests: []manifestDescriptor{{
+			MediaType: "application/vnd.oci.image.manifest.v1+json",
+			Digest:    imageDigest,
+			Size:      int64(len(image)),
+		}},
+	})
+	indexDigest := source.putManifest("metabase/metabase", "v0.47.0", string(index))
+
+	sourceImage := fmt.Sprintf("%s/metabase/metabase:v0.47.0@%s", source.host(), indexDigest)
+	destinationImage := fmt.Sprintf("%s/mirror:v0.47.0", destination.host())
+	if err := CopyImage(context.Background(), sourceImage, destinationImage, destination.credentials); err != nil {
+		t.Fatalf("CopyImage(%q, %q) = %v", sourceImage, destinationImage, err)
+	}
+
+	tagged, ok := destination.manifest("mirror", "v0.47.0")
+	if !ok || testDigest(tagged.body) != indexDigest || tagged.mediaType != "application/vnd.oci.image.index.v1+json" {
+		t.Errorf("the mirror's v0.47.0 tag = %s (%s), want the index %s", testDigest(tagged.body), tagged.mediaType, indexDigest)
+	}
+	if _, ok := destination.manifest("mirror", imageDigest); !ok {
+		t.Errorf("the mirror is missing the image manifest %s", imageDigest)
+	}
+	for _, blob := range []manifestDescriptor{config, layer} {
+		if _, ok := destination.blobs[blob.Digest]; !ok {
+			t.Errorf("the mirror is missing the blob %s", blob.Digest)
+		}
+	}
+
+	// Copying the image again finds it in the mirror and uploads nothing.
+	uploads := destination.uploadCount()
+	if err := CopyImage(context.Background(), sourceImage, destinationImage, destination.credentials); err != nil {
+		t.Fatalf("copying the image again = %v", err)
+	}
+	if destination.uploadCount() != uploads {
+		t.Errorf("copying the image again made %d uploads, want none", destination.uploadCount()-uploads)
+	}
+}
+
+func TestCopyImageErrors(t *testing.T) {
+	source := newTestRegistry(t, "")
+	destination := newTestRegistry(t, "QVdTOnRva2Vu")
+	useRegistryClient(t, source.server, destination.server)
+	image := source.putManifest("metabase/metabase", "v0.47.0", `{"mediaType": "application/vnd.oci.image.manifest.v1+json"}`)
+
+	tests := []struct {
+		name        string
+		source      string
+		destination string
+		token       string
+	}{
+		{
+			name:        "missing tag",
+			source:      source.host() + "/metabase/metabase:v0.46.0",
+			destination: destination.host() + "/mirror:v0.46.0",
+			token:       destination.credentials,
+		},
+		{
+			name:        "wrong credentials",
+			source:      source.host() + "/metabase/metabase:v0.47.0@" + image,
+			destination: destination.host() + "/mirror:v0.47.0",
+			token:       "d3Jvbmc=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CopyImage(context.Background(), tt.source, tt.destination, tt.token); err == nil {
+				t.Errorf("CopyImage(%q, %q) succeeded, want an error", tt.source, tt.destination)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package metabase
+
+import (
+	"fmt"
+)
+
+// The number of days Metabase logs are kept in CloudWatch when no retention is provided.
+const DefaultLogRetentionInDays = 30
+
+// The retention periods accepted by CloudWatch Logs, 0 means the logs never expire.
+var validLogRetentionInDays = []int{0, 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653}
+
+func ValidateLogRetention(days int) error {
+	for _, valid := range validLogRetentionInDays {
+		if days == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("logging.retentionInDays must be one of %v, got %d", validLogRetentionInDays, days)
+}
+
+// AWSLogsConfiguration returns the `logConfiguration` block of an ECS container definition
+// that ships the container's stdout and stderr to the given CloudWatch log group.
+func AWSLogsConfiguration(logGroupName, region, streamPrefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"logDriver": "awslogs",
+		"options": map[string]interface{}{
+			"awslogs-group":         logGroupName,
+			"awslogs-region":        region,
+			"awslogs-stream-prefix": streamPrefix,
+		},
+	}
+}
+
+// The name of the Fluent Bit sidecar that routes the Metabase logs when FireLens is enabled.
+const FireLensContainerName = "log-router"
+
+// The AWS for Fluent Bit image used when no FireLens image is provided.
+const DefaultFireLensImage = "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"
+
+// FireLensContainer returns the Fluent Bit sidecar container definition. The log router's own
+// logs are sent to CloudWatch so a misconfigured output plugin can still be debugged.
+func FireLensContainer(image, logGroupName, region string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":      FireLensContainerName,
+		"image":     image,
+		"essential": true,
+		"firelensConfiguration": map[string]interface{}{
+			"type": "fluentbit",
+			"options": map[string]interface{}{
+				"enable-ecs-log-metadata": "true",
+			},
+		},
+		"logConfiguration": AWSLogsConfiguration(logGroupName, region, FireLensContainerName),
+	}
+}
+
+// FireLensLogConfiguration returns the `logConfiguration` block that routes a container's logs
+// through the FireLens sidecar. The options are passed as is to the Fluent Bit output plugin.
+func FireLensLogConfiguration(options map[string]string) map[string]interface{} {
+	return map[string]interface{}{
+		"logDriver": "awsfirelens",
+		"options":   options,
+	}
+}
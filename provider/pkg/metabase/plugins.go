@@ -0,0 +1,130 @@
+package metabase
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// PluginsDir is where the plugins volume is mounted, and what `MB_PLUGINS_DIR` points Metabase at.
+	PluginsDir        = "/plugins"
+	PluginsVolumeName = "plugins"
+
+	// The container that downloads the plugins before Metabase starts.
+	PluginsInitContainerName = "plugins-init"
+	// Any image with a shell, wget and sha256sum can download the plugins.
+	DefaultPluginsInitImage = "public.ecr.aws/docker/library/alpine:3"
+
+	// Lists the plugins the init container installed, so the ones dropped from the component's
+	// plugins can be told apart from the drivers Metabase extracts into the directory.
+	pluginsManifest = PluginsDir + "/.installed-plugins"
+)
+
+var sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Plugin is a Metabase driver JAR, such as a community database driver.
+type Plugin struct {
+	URL    string
+	SHA256 string
+}
+
+func (p Plugin) fileName() string {
+	u, _ := url.Parse(p.URL)
+	return path.Base(u.Path)
+}
+
+// ValidatePlugins checks every plugin is an HTTPS URL to a JAR file with a SHA-256 checksum.
+func ValidatePlugins(plugins []Plugin) error {
+	fileNames := map[string]bool{}
+	for _, p := range plugins {
+		u, err := url.Parse(p.URL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("plugins url must be an https URL, got %q", p.URL)
+		}
+		// The URL is passed to the download script, so keep it free of shell syntax.
+		if strings.ContainsAny(p.URL, "\"$`\\ ") {
+			return fmt.Errorf("plugins url can't contain quotes, backslashes, spaces or `$`, got %q", p.URL)
+		}
+		if !strings.HasSuffix(u.Path, ".jar") {
+			return fmt.Errorf("plugins url must point to a .jar file, got %q", p.URL)
+		}
+		if !sha256Pattern.MatchString(p.SHA256) {
+			return fmt.Errorf("plugins sha256 of %q must be a hex encoded SHA-256 checksum, got %q", p.URL, p.SHA256)
+		}
+		if fileNames[p.fileName()] {
+			return fmt.Errorf("plugins contains more than one %q", p.fileName())
+		}
+		fileNames[p.fileName()] = true
+	}
+	return nil
+}
+
+// pluginsScript downloads the plugins that aren't already in the plugins directory and checks
+// their checksums. A plugin is only moved into place once its checksum matches, so Metabase never
+// loads a partially downloaded or tampered JAR. Plugins installed by an earlier deployment that are
+// no longer listed are removed, other files in the directory are left alone since Metabase extracts
+// its bundled drivers there too.
+func pluginsScript(plugins []Plugin) string {
+	lines := []string{"set -eu"}
+	fileNames := make([]string, 0, len(plugins))
+	for _, p := range plugins {
+		file := fmt.Sprintf("%s/%s", PluginsDir, p.fileName())
+		lines = append(lines,
+			fmt.Sprintf(`if ! echo "%s  %s" | sha256sum -c -s; then`, p.SHA256, file),
+			fmt.Sprintf(`  wget -q -O "%s.download" "%s"`, file, p.URL),
+			fmt.Sprintf(`  echo "%s  %s.download" | sha256sum -c`, p.SHA256, file),
+			fmt.Sprintf(`  mv "%s.download" "%s"`, file, file),
+			"fi",
+		)
+		fileNames = append(fileNames, fmt.Sprintf(`"%s"`, p.fileName()))
+	}
+
+	lines = append(lines,
+		fmt.Sprintf(`if [ -f "%s" ]; then`, pluginsManifest),
+		`  while read -r file; do`,
+		`    case "$file" in`,
+	)
+	if len(fileNames) > 0 {
+		lines = append(lines, fmt.Sprintf(`    %s) ;;`, strings.Join(fileNames, "|")))
+	}
+	lines = append(lines,
+		fmt.Sprintf(`    ?*) rm -f "%s/$file" ;;`, PluginsDir),
+		`    esac`,
+		fmt.Sprintf(`  done < "%s"`, pluginsManifest),
+		"fi",
+	)
+
+	if len(fileNames) > 0 {
+		lines = append(lines, fmt.Sprintf(`printf '%%s\n' %s > "%s.tmp"`, strings.Join(fileNames, " "), pluginsManifest))
+	} else {
+		lines = append(lines, fmt.Sprintf(`: > "%s.tmp"`, pluginsManifest))
+	}
+	lines = append(lines, fmt.Sprintf(`mv "%s.tmp" "%s"`, pluginsManifest, pluginsManifest))
+	return strings.Join(lines, "\n")
+}
+
+// PluginsMountPoint mounts the plugins volume into a container.
+func PluginsMountPoint() map[string]interface{} {
+	return map[string]interface{}{
+		"sourceVolume":  PluginsVolumeName,
+		"containerPath": PluginsDir,
+	}
+}
+
+// PluginsInitContainer returns the container definition that downloads the plugins into the
+// plugins volume and removes the ones no longer listed. It exits once it's done, Metabase waits
+// for it to succeed.
+func PluginsInitContainer(image string, plugins []Plugin, logGroupName, region string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":             PluginsInitContainerName,
+		"image":            image,
+		"essential":        false,
+		"entryPoint":       []string{"sh", "-c"},
+		"command":          []string{pluginsScript(plugins)},
+		"mountPoints":      []map[string]interface{}{PluginsMountPoint()},
+		"logConfiguration": AWSLogsConfiguration(logGroupName, region, PluginsInitContainerName),
+	}
+}
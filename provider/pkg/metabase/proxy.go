@@ -0,0 +1,135 @@
+package metabase
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/rds"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/secretsmanager"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func (m *MetabaseResourceConstructor) rdsAssumeRolePolicy() (string, error) {
+	assumeRolePolicy, err := iam.GetPolicyDocument(m.ctx, &iam.GetPolicyDocumentArgs{
+		Statements: []iam.GetPolicyDocumentStatement{
+			{
+				Actions: []string{
+					"sts:AssumeRole",
+				},
+				Principals: []iam.GetPolicyDocumentStatementPrincipal{
+					{
+						Type: "Service",
+						Identifiers: []string{
+							"rds.amazonaws.com",
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return assumeRolePolicy.Json, nil
+}
+
+// NewDatabaseProxy creates an RDS Proxy in front of the cluster, which pools the connections of
+// the Metabase tasks so scaling out doesn't exhaust the database's connections. The proxy logs in
+// with the cluster credentials stored in Secrets Manager, which its IAM role is allowed to read.
+// Metabase keeps authenticating with the same credentials. The returned endpoint is taken from the
+// proxy target so Metabase isn't started before the cluster has been registered with the proxy.
+func (m *MetabaseResourceConstructor) NewDatabaseProxy(cluster *rds.Cluster, instance *rds.ClusterInstance, dbSubnetIDs pulumi.StringArrayInput, metabaseSecurityGroupID pulumi.IDOutput) (pulumi.StringOutput, error) {
+	secretName := fmt.Sprintf("%s-database-credentials", m.baseResourceName)
+	secret, err := secretsmanager.NewSecret(m.ctx, secretName, &secretsmanager.SecretArgs{
+		Description: pulumi.String("Metabase application database credentials"),
+	}, m.opts...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	credentials := pulumi.All(cluster.MasterUsername, cluster.MasterPassword).ApplyT(func(values []interface{}) (string, error) {
+		credentials, err := json.Marshal(map[string]string{
+			"username": values[0].(string),
+			"password": *values[1].(*string),
+		})
+		return string(credentials), err
+	}).(pulumi.StringOutput)
+	secretVersion, err := secretsmanager.NewSecretVersion(m.ctx, secretName, &secretsmanager.SecretVersionArgs{
+		SecretId:     secret.ID(),
+		SecretString: credentials,
+	}, m.opts...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	assumeRolePolicy, err := m.rdsAssumeRolePolicy()
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	proxyRoleName := fmt.Sprintf("%s-proxyRole", m.baseResourceName)
+	proxyRole, err := iam.NewRole(m.ctx, proxyRoleName, &iam.RoleArgs{
+		AssumeRolePolicy: pulumi.String(assumeRolePolicy),
+	}, m.opts...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	secretsPolicy := iam.GetPolicyDocumentOutput(m.ctx, iam.GetPolicyDocumentOutputArgs{
+		Statements: iam.GetPolicyDocumentStatementArray{
+			iam.GetPolicyDocumentStatementArgs{
+				Actions: pulumi.ToStringArray([]string{
+					"secretsmanager:GetSecretValue",
+				}),
+				Resources: pulumi.StringArray{secret.Arn},
+			},
+		},
+	})
+
+	proxyRolePolicyName := fmt.Sprintf("%s-proxyRoleSecretsPolicy", m.baseResourceName)
+	proxyRolePolicy, err := iam.NewRolePolicy(m.ctx, proxyRolePolicyName, &iam.RolePolicyArgs{
+		Role:   proxyRole.Name,
+		Policy: secretsPolicy.Json(),
+	}, m.opts...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	proxy, err := rds.NewProxy(m.ctx, m.baseResourceName, &rds.ProxyArgs{
+		EngineFamily: pulumi.String("MYSQL"),
+		Auths: rds.ProxyAuthArray{
+			rds.ProxyAuthArgs{
+				AuthScheme: pulumi.String("SECRETS"),
+				IamAuth:    pulumi.String("DISABLED"),
+				SecretArn:  secretVersion.Arn,
+			},
+		},
+		RoleArn:             proxyRole.Arn,
+		VpcSubnetIds:        dbSubnetIDs,
+		VpcSecurityGroupIds: pulumi.StringArray{metabaseSecurityGroupID.ToStringOutput()},
+	}, append(m.opts, pulumi.DependsOn([]pulumi.Resource{proxyRolePolicy}))...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	targetGroup, err := rds.NewProxyDefaultTargetGroup(m.ctx, m.baseResourceName, &rds.ProxyDefaultTargetGroupArgs{
+		DbProxyName: proxy.Name,
+	}, m.opts...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	target, err := rds.NewProxyTarget(m.ctx, m.baseResourceName, &rds.ProxyTargetArgs{
+		DbProxyName:         proxy.Name,
+		TargetGroupName:     targetGroup.Name,
+		DbClusterIdentifier: cluster.ClusterIdentifier,
+	}, append(m.opts, pulumi.DependsOn([]pulumi.Resource{instance}))...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	return pulumi.All(proxy.Endpoint, target.ID()).ApplyT(func(values []interface{}) string {
+		return values[0].(string)
+	}).(pulumi.StringOutput), nil
+}
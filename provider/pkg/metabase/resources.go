@@ -2,15 +2,21 @@ package metabase
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/acm"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/appautoscaling"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/cloudwatch"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ec2"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ecs"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/lb"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/rds"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/route53"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/secretsmanager"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ssm"
 	"github.com/pulumi/pulumi-random/sdk/v4/go/random"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -127,7 +133,13 @@ func (m *MetabaseResourceConstructor) NewMetabasePassword() (*random.RandomStrin
 	}, m.opts...)
 }
 
-func (m *MetabaseResourceConstructor) NewMySQLCluster(dbSubnetIDs pulumi.StringArrayInput, metabasePassword *random.RandomString, metabaseSecurityGroupID pulumi.IDOutput, engineVersion pulumi.StringInput) (*rds.Cluster, error) {
+func (m *MetabaseResourceConstructor) mysqlClusterIdentifier() string {
+	return fmt.Sprintf("%smetabasemysql", m.name)
+}
+
+// NewMySQLCluster creates the Aurora Serverless MySQL cluster. An Aurora Serverless v2 cluster needs
+// an instance, which is created with NewServerlessV2Instance.
+func (m *MetabaseResourceConstructor) NewMySQLCluster(dbSubnetIDs pulumi.StringArrayInput, metabasePassword *random.RandomString, metabaseSecurityGroupID pulumi.IDOutput, engineVersion pulumi.StringInput, autoPause, serverlessV2 bool) (*rds.Cluster, error) {
 	metabaseMysqlSubnetGroup, err := rds.NewSubnetGroup(m.ctx, m.baseResourceName, &rds.SubnetGroupArgs{
 		SubnetIds: dbSubnetIDs,
 	}, m.opts...)
@@ -135,16 +147,12 @@ func (m *MetabaseResourceConstructor) NewMySQLCluster(dbSubnetIDs pulumi.StringA
 		return nil, err
 	}
 
-	if engineVersion == nil {
-		engineVersion = pulumi.String("5.7.mysql_aurora.2.08.3")
-	}
-
 	clusterArgs := &rds.ClusterArgs{
-		ClusterIdentifier:       pulumi.Sprintf("%smetabasemysql", m.name),
+		ClusterIdentifier:       pulumi.String(m.mysqlClusterIdentifier()),
 		DatabaseName:            pulumi.String("metabase"),
 		MasterUsername:          pulumi.String("admin"),
 		MasterPassword:          metabasePassword.Result,
-		Engine:                  pulumi.String("aurora-mysql"),
+		Engine:                  pulumi.String(AuroraMySQLEngine),
 		EngineMode:              pulumi.String("serverless"),
 		EngineVersion:           engineVersion,
 		VpcSecurityGroupIds:     pulumi.ToStringArrayOutput([]pulumi.StringOutput{metabaseSecurityGroupID.ToStringOutput()}),
@@ -153,9 +161,114 @@ func (m *MetabaseResourceConstructor) NewMySQLCluster(dbSubnetIDs pulumi.StringA
 		DbSubnetGroupName:       metabaseMysqlSubnetGroup.Name,
 	}
 
+	switch {
+	case serverlessV2:
+		// Aurora Serverless v2 can't pause and doesn't support the Data API.
+		clusterArgs.EngineMode = pulumi.String("provisioned")
+		clusterArgs.EnableHttpEndpoint = nil
+		clusterArgs.Serverlessv2ScalingConfiguration = &rds.ClusterServerlessv2ScalingConfigurationArgs{
+			MinCapacity: pulumi.Float64(serverlessV2MinCapacity),
+			MaxCapacity: pulumi.Float64(serverlessV2MaxCapacity),
+		}
+	case autoPause:
+		// Aurora Serverless pauses idle clusters by default, this makes sure the cluster is paused
+		// shortly after the schedule has stopped Metabase.
+		clusterArgs.ScalingConfiguration = &rds.ClusterScalingConfigurationArgs{
+			AutoPause:             pulumi.BoolPtr(true),
+			SecondsUntilAutoPause: pulumi.IntPtr(300),
+		}
+	}
+
 	return rds.NewCluster(m.ctx, m.baseResourceName, clusterArgs, m.opts...)
 }
 
+// NewPreUpgradeSnapshot takes a manual snapshot of the application database before Metabase is upgraded
+// from one version to another, since Metabase's migrations can't be rolled back. The snapshot is kept
+// when it's dropped from the program on the next deployment.
+func (m *MetabaseResourceConstructor) NewPreUpgradeSnapshot(cluster *rds.Cluster, fromVersion, toVersion string) (*rds.ClusterSnapshot, error) {
+	// Snapshot identifiers can only contain letters, digits and hyphens.
+	identifier := fmt.Sprintf("%smetabase-%s-to-%s", m.name,
+		strings.ReplaceAll(fromVersion, ".", "-"), strings.ReplaceAll(toVersion, ".", "-"))
+
+	snapshotName := fmt.Sprintf("%s-pre-upgrade", m.baseResourceName)
+	opts := append(m.opts, pulumi.RetainOnDelete(true))
+	return rds.NewClusterSnapshot(m.ctx, snapshotName, &rds.ClusterSnapshotArgs{
+		DbClusterIdentifier:         cluster.ClusterIdentifier,
+		DbClusterSnapshotIdentifier: pulumi.String(identifier),
+	}, opts...)
+}
+
+// NewPremiumEmbeddingTokenSecret stores the Metabase Enterprise token in Secrets Manager so it can be
+// passed to the container without showing up in the task definition. The returned ARN is taken from
+// the secret version so the task isn't started before the token has been stored.
+func (m *MetabaseResourceConstructor) NewPremiumEmbeddingTokenSecret(token pulumi.StringInput) (pulumi.StringOutput, error) {
+	secretName := fmt.Sprintf("%s-premium-embedding-token", m.baseResourceName)
+	secret, err := secretsmanager.NewSecret(m.ctx, secretName, &secretsmanager.SecretArgs{
+		Description: pulumi.String("Metabase Enterprise premium embedding token"),
+	}, m.opts...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	secretVersion, err := secretsmanager.NewSecretVersion(m.ctx, secretName, &secretsmanager.SecretVersionArgs{
+		SecretId:     secret.ID(),
+		SecretString: token,
+	}, m.opts...)
+	if err != nil {
+		return pulumi.StringOutput{}, err
+	}
+
+	return secretVersion.Arn, nil
+}
+
+// The SSM parameter the component records the last deployed Metabase version in, so the next
+// deployment can tell whether it's an upgrade or a downgrade. The version tag is followed by the
+// `@digest` of the image when it's pinned, since a tag such as `latest` can move to a new release.
+func (m *MetabaseResourceConstructor) versionParameterName() string {
+	return fmt.Sprintf("/pulumi/metabase/%s/%s/%s/version", m.ctx.Project(), m.ctx.Stack(), m.name)
+}
+
+// LookupDeployedVersion returns the Metabase version tag recorded by the last deployment and the
+// digest of its image, or empty strings when Metabase hasn't been deployed yet. The digest is empty
+// when the image wasn't pinned.
+func (m *MetabaseResourceConstructor) LookupDeployedVersion() (string, string, error) {
+	name := m.versionParameterName()
+	parameters, err := ssm.GetParametersByPath(m.ctx, &ssm.GetParametersByPathArgs{
+		Path: path.Dir(name),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	for i, parameterName := range parameters.Names {
+		if parameterName == name {
+			version, digest, _ := strings.Cut(parameters.Values[i], "@")
+			return version, digest, nil
+		}
+	}
+	return "", "", nil
+}
+
+// NewVersionParameter records the deployed Metabase version, and the digest of its image when it's
+// pinned, once the service has been updated.
+func (m *MetabaseResourceConstructor) NewVersionParameter(version pulumi.StringInput, digest pulumi.StringInput, service *ecs.Service) (*ssm.Parameter, error) {
+	value := pulumi.All(version, digest).ApplyT(func(values []interface{}) string {
+		version, digest := values[0].(string), values[1].(string)
+		if digest == "" {
+			return version
+		}
+		return fmt.Sprintf("%s@%s", version, digest)
+	}).(pulumi.StringOutput)
+
+	versionParameterName := fmt.Sprintf("%s-version", m.baseResourceName)
+	opts := append(m.opts, pulumi.DependsOn([]pulumi.Resource{service}))
+	return ssm.NewParameter(m.ctx, versionParameterName, &ssm.ParameterArgs{
+		Name:  pulumi.String(m.versionParameterName()),
+		Type:  pulumi.String("String"),
+		Value: value,
+	}, opts...)
+}
+
 func (m *MetabaseResourceConstructor) GetHostedZoneId(hostedZoneName pulumi.StringInput) pulumi.StringOutput {
 	return hostedZoneName.ToStringOutput().ApplyT(func(name string) (string, error) {
 		hostedZone, err := route53.LookupZone(m.ctx, &route53.LookupZoneArgs{
@@ -210,7 +323,7 @@ func (m *MetabaseResourceConstructor) NewLoadBalancer(
 	vpcID pulumi.StringInput, lbSubnetIDs pulumi.StringArrayInput,
 	loadBalancerSecurityGroupID pulumi.IDOutput, metabasePort int,
 	certificateValidation *acm.CertificateValidation, certificate *acm.Certificate,
-	attachDomain bool) (*lb.LoadBalancer, *lb.TargetGroup, *lb.Listener, error) {
+	attachDomain bool, healthCheck HealthCheck) (*lb.LoadBalancer, *lb.TargetGroup, *lb.Listener, error) {
 	// Stable load balancer endpoint (no other way to get a consistent IP for an ECS service!!!)
 	loadBalancer, err := lb.NewLoadBalancer(m.ctx, m.baseResourceName, &lb.LoadBalancerArgs{
 		LoadBalancerType: pulumi.String("application"),
@@ -230,6 +343,7 @@ func (m *MetabaseResourceConstructor) NewLoadBalancer(
 		// Since this is a user facing tool, and we only have 0 or 1 running instances, we don't need to wait to
 		// drain connections, and instead want to ensure we have as little downtime as possible.
 		DeregistrationDelay: pulumi.Int(0),
+		HealthCheck:         healthCheck.targetGroupHealthCheck(),
 	}, m.opts...)
 	if err != nil {
 		return nil, nil, nil, err
@@ -291,7 +405,15 @@ func (m *MetabaseResourceConstructor) NewLoadBalancer(
 	return loadBalancer, targetGroup, listener, nil
 }
 
-func (m *MetabaseResourceConstructor) newECSExecutionRole(name string) (*iam.Role, error) {
+func (m *MetabaseResourceConstructor) NewLogGroup(retentionInDays int, kmsKeyID pulumi.StringInput) (*cloudwatch.LogGroup, error) {
+	return cloudwatch.NewLogGroup(m.ctx, m.baseResourceName, &cloudwatch.LogGroupArgs{
+		RetentionInDays: pulumi.IntPtr(retentionInDays),
+		// The key policy must allow the `logs.<region>.amazonaws.com` service principal to use the key.
+		KmsKeyId: kmsKeyID,
+	}, m.opts...)
+}
+
+func (m *MetabaseResourceConstructor) ecsTasksAssumeRolePolicy() (string, error) {
 	assumeRolePolicy, err := iam.GetPolicyDocument(m.ctx, &iam.GetPolicyDocumentArgs{
 		Statements: []iam.GetPolicyDocumentStatement{
 			{
@@ -309,13 +431,21 @@ func (m *MetabaseResourceConstructor) newECSExecutionRole(name string) (*iam.Rol
 			},
 		},
 	})
+	if err != nil {
+		return "", err
+	}
+	return assumeRolePolicy.Json, nil
+}
+
+func (m *MetabaseResourceConstructor) newECSExecutionRole(name string, secretARNs pulumi.StringArrayInput) (*iam.Role, error) {
+	assumeRolePolicy, err := m.ecsTasksAssumeRolePolicy()
 	if err != nil {
 		return nil, err
 	}
 
 	ecsTaskExecutionRoleName := fmt.Sprintf("%s-ecsTaskExecutionRole", name)
 	ecsTaskExecutionRole, err := iam.NewRole(m.ctx, ecsTaskExecutionRoleName, &iam.RoleArgs{
-		AssumeRolePolicy: pulumi.String(assumeRolePolicy.Json),
+		AssumeRolePolicy: pulumi.String(assumeRolePolicy),
 	}, m.opts...)
 	if err != nil {
 		return nil, err
@@ -330,26 +460,147 @@ func (m *MetabaseResourceConstructor) newECSExecutionRole(name string) (*iam.Rol
 		return nil, err
 	}
 
+	// ECS reads the container secrets with the execution role before the task starts.
+	if secretARNs != nil {
+		secretsPolicy := iam.GetPolicyDocumentOutput(m.ctx, iam.GetPolicyDocumentOutputArgs{
+			Statements: iam.GetPolicyDocumentStatementArray{
+				iam.GetPolicyDocumentStatementArgs{
+					Actions: pulumi.ToStringArray([]string{
+						"secretsmanager:GetSecretValue",
+						"ssm:GetParameters",
+					}),
+					Resources: secretARNs,
+				},
+			},
+		})
+
+		ecsTaskExecutionRoleSecretsPolicyName := fmt.Sprintf("%s-ecsTaskExecutionRoleSecretsPolicy", name)
+		_, err = iam.NewRolePolicy(m.ctx, ecsTaskExecutionRoleSecretsPolicyName, &iam.RolePolicyArgs{
+			Role:   ecsTaskExecutionRole.Name,
+			Policy: secretsPolicy.Json(),
+		}, m.opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return ecsTaskExecutionRole, nil
 }
 
-func (m *MetabaseResourceConstructor) NewMetabaseService(
-	metabaseVersion pulumi.StringInput, regionName pulumi.StringOutput,
-	metabaseContainerDef pulumi.StringOutput, ecsSubnetIDs pulumi.StringArrayInput,
-	metabaseSecurityGroupID pulumi.IDOutput, metabasePort int, targetGroupARN pulumi.StringOutput,
-	lbListener *lb.Listener, assignPublicIP bool,
-) error {
+// NewECSTaskRole creates the role assumed by the Metabase container, letting it use IAM credentials
+// to connect to data sources such as Athena, S3 or Redshift.
+func (m *MetabaseResourceConstructor) NewECSTaskRole(policies TaskRolePolicies) (*iam.Role, error) {
+	assumeRolePolicy, err := m.ecsTasksAssumeRolePolicy()
+	if err != nil {
+		return nil, err
+	}
 
-	metabaseCluster, err := ecs.NewCluster(m.ctx, m.baseResourceName, &ecs.ClusterArgs{}, m.opts...)
+	// The preset policies name resources in the partition of the account, such as aws-cn in China.
+	partition, err := aws.GetPartition(m.ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	metabaseExecutionRole, err := m.newECSExecutionRole(m.baseResourceName)
+	ecsTaskRoleName := fmt.Sprintf("%s-ecsTaskRole", m.baseResourceName)
+	return iam.NewRole(m.ctx, ecsTaskRoleName, &iam.RoleArgs{
+		AssumeRolePolicy:  pulumi.String(assumeRolePolicy),
+		ManagedPolicyArns: taskRoleManagedPolicyARNs(policies, partition.Partition),
+		InlinePolicies:    m.taskRoleInlinePolicies(policies, partition.Partition),
+	}, m.opts...)
+}
+
+type MetabaseServiceArgs struct {
+	ContainerDefinitions pulumi.StringOutput
+	SubnetIDs            pulumi.StringArrayInput
+	SecurityGroupID      pulumi.IDOutput
+	AssignPublicIP       bool
+	Port                 int
+	TargetGroupARN       pulumi.StringOutput
+	Listener             *lb.Listener
+	HealthCheck          HealthCheck
+	TaskRole             *iam.Role
+
+	// Secrets Manager and SSM Parameter Store ARNs read by ECS when starting the task.
+	SecretARNs pulumi.StringArrayInput
+	// The CPU architecture to run the task on, the Fargate default when empty.
+	Architecture Architecture
+	// Whether to run the task on Fargate Spot, regular Fargate, or a mix of both. The service uses
+	// the FARGATE launch type instead of a capacity provider strategy when empty.
+	Capacity Capacity
+	// When set, the service is scaled to zero and back up on a schedule.
+	Schedule *Schedule
+	// Enables ECS Exec into the Metabase container when set. The task role must allow it.
+	ExecuteCommand *ExecuteCommand
+	// EFS volumes mounted into the task.
+	Volumes []EFSVolume
+	// The ARN of an existing cluster to run the service on. A cluster is created when nil, the
+	// settings, capacity providers and ECS Exec configuration of an existing cluster are left to its owner.
+	ClusterARN pulumi.StringInput
+	// Settings of the cluster created when ClusterARN is nil.
+	Cluster *ClusterSettings
+	// Resources that have to be created before the task definition is replaced, such as the
+	// pre-upgrade database snapshot.
+	DependsOn []pulumi.Resource
+}
+
+func (m *MetabaseResourceConstructor) NewMetabaseService(args MetabaseServiceArgs) (*ecs.Service, error) {
+	serviceDependencies := append([]pulumi.Resource{args.Listener}, args.DependsOn...)
+
+	var clusterARN, clusterName pulumi.StringInput
+	if args.ClusterARN != nil {
+		clusterARN = args.ClusterARN
+		clusterName = args.ClusterARN.ToStringOutput().ApplyT(ParseClusterARN).(pulumi.StringOutput)
+	} else {
+		clusterArgs := args.Cluster.clusterArgs()
+		if args.ExecuteCommand != nil {
+			clusterArgs.Configuration = args.ExecuteCommand.clusterConfiguration()
+		}
+		metabaseCluster, err := ecs.NewCluster(m.ctx, m.baseResourceName, clusterArgs, m.opts...)
+		if err != nil {
+			return nil, err
+		}
+		clusterARN, clusterName = metabaseCluster.Arn, metabaseCluster.Name
+
+		defaultStrategies := args.Cluster.defaultCapacityProviderStrategies()
+		if args.Capacity != "" || len(defaultStrategies) > 0 {
+			capacityProviders, err := ecs.NewClusterCapacityProviders(m.ctx, m.baseResourceName, &ecs.ClusterCapacityProvidersArgs{
+				ClusterName:                       metabaseCluster.Name,
+				CapacityProviders:                 pulumi.ToStringArray([]string{"FARGATE", "FARGATE_SPOT"}),
+				DefaultCapacityProviderStrategies: defaultStrategies,
+			}, m.opts...)
+			if err != nil {
+				return nil, err
+			}
+			serviceDependencies = append(serviceDependencies, capacityProviders)
+		}
+	}
+
+	launchType := pulumi.StringPtr("FARGATE")
+	var capacityProviderStrategies ecs.ServiceCapacityProviderStrategyArrayInput
+	if args.Capacity != "" {
+		launchType = nil
+		capacityProviderStrategies = args.Capacity.capacityProviderStrategies()
+	}
+
+	metabaseExecutionRole, err := m.newECSExecutionRole(m.baseResourceName, args.SecretARNs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var runtimePlatform ecs.TaskDefinitionRuntimePlatformPtrInput
+	if args.Architecture != "" {
+		runtimePlatform = &ecs.TaskDefinitionRuntimePlatformArgs{
+			CpuArchitecture:       pulumi.String(args.Architecture.CPUArchitecture()),
+			OperatingSystemFamily: pulumi.String("LINUX"),
+		}
+	}
+
+	var volumes ecs.TaskDefinitionVolumeArray
+	for _, volume := range args.Volumes {
+		volumes = append(volumes, volume.taskDefinitionVolume())
+	}
+
+	taskDefinitionOpts := append(m.opts, pulumi.DependsOn(args.DependsOn))
 	metabaseTaskDefinition, err := ecs.NewTaskDefinition(m.ctx, m.baseResourceName, &ecs.TaskDefinitionArgs{
 		Family:                  pulumi.String("metabase"),
 		Cpu:                     pulumi.String("2048"),
@@ -357,32 +608,106 @@ func (m *MetabaseResourceConstructor) NewMetabaseService(
 		RequiresCompatibilities: pulumi.ToStringArray([]string{"FARGATE"}),
 		NetworkMode:             pulumi.StringPtr("awsvpc"),
 		ExecutionRoleArn:        metabaseExecutionRole.Arn,
-		ContainerDefinitions:    metabaseContainerDef,
-	}, m.opts...)
+		TaskRoleArn:             args.TaskRole.Arn,
+		ContainerDefinitions:    args.ContainerDefinitions,
+		RuntimePlatform:         runtimePlatform,
+		Volumes:                 volumes,
+	}, taskDefinitionOpts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	serviceOpts := append(m.opts, pulumi.DependsOn([]pulumi.Resource{lbListener}))
-	_, err = ecs.NewService(m.ctx, m.baseResourceName, &ecs.ServiceArgs{
-		Cluster:                         metabaseCluster.Arn,
+	serviceOpts := append(m.opts, pulumi.DependsOn(serviceDependencies))
+	if args.Schedule != nil {
+		// The desired count is managed by the schedule once the service exists.
+		serviceOpts = append(serviceOpts, pulumi.IgnoreChanges([]string{"desiredCount"}))
+	}
+	// Metabase runs as a single task that's stopped before its replacement starts, so an H2
+	// application database is never opened by two Metabase instances at once.
+	metabaseService, err := ecs.NewService(m.ctx, m.baseResourceName, &ecs.ServiceArgs{
+		Cluster:                         clusterARN,
 		TaskDefinition:                  metabaseTaskDefinition.Arn,
 		DesiredCount:                    pulumi.Int(1),
 		DeploymentMaximumPercent:        pulumi.IntPtr(100),
 		DeploymentMinimumHealthyPercent: pulumi.IntPtr(0),
-		LaunchType:                      pulumi.String("FARGATE"),
+		EnableExecuteCommand:            pulumi.BoolPtr(args.ExecuteCommand != nil),
+		LaunchType:                      launchType,
+		CapacityProviderStrategies:      capacityProviderStrategies,
+		// Keep the load balancer from killing the task while Metabase runs its migrations.
+		HealthCheckGracePeriodSeconds: pulumi.IntPtr(args.HealthCheck.StartPeriod),
 		NetworkConfiguration: &ecs.ServiceNetworkConfigurationArgs{
-			AssignPublicIp: pulumi.BoolPtr(assignPublicIP),
-			Subnets:        ecsSubnetIDs,
-			SecurityGroups: pulumi.ToStringArrayOutput([]pulumi.StringOutput{metabaseSecurityGroupID.ToStringOutput()}),
+			AssignPublicIp: pulumi.BoolPtr(args.AssignPublicIP),
+			Subnets:        args.SubnetIDs,
+			SecurityGroups: pulumi.ToStringArrayOutput([]pulumi.StringOutput{args.SecurityGroupID.ToStringOutput()}),
 		},
 		LoadBalancers: ecs.ServiceLoadBalancerArray{
 			ecs.ServiceLoadBalancerArgs{
 				ContainerName:  pulumi.String("metabase"),
-				ContainerPort:  pulumi.Int(metabasePort),
-				TargetGroupArn: targetGroupARN,
+				ContainerPort:  pulumi.Int(args.Port),
+				TargetGroupArn: args.TargetGroupARN,
 			},
 		},
 	}, serviceOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Schedule != nil {
+		err = m.newServiceSchedule(clusterName, metabaseService, *args.Schedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return metabaseService, nil
+}
+
+// newServiceSchedule registers the service with Application Auto Scaling and adds the scheduled
+// actions that scale it to zero tasks and back to one.
+func (m *MetabaseResourceConstructor) newServiceSchedule(clusterName pulumi.StringInput, service *ecs.Service, schedule Schedule) error {
+	targetName := fmt.Sprintf("%s-scaling-target", m.baseResourceName)
+	target, err := appautoscaling.NewTarget(m.ctx, targetName, &appautoscaling.TargetArgs{
+		ServiceNamespace:  pulumi.String("ecs"),
+		ScalableDimension: pulumi.String("ecs:service:DesiredCount"),
+		ResourceId:        pulumi.Sprintf("service/%s/%s", clusterName, service.Name),
+		MinCapacity:       pulumi.Int(0),
+		MaxCapacity:       pulumi.Int(1),
+	}, m.opts...)
+	if err != nil {
+		return err
+	}
+
+	scaleDownName := fmt.Sprintf("%s-scale-down", m.baseResourceName)
+	scaleDown, err := appautoscaling.NewScheduledAction(m.ctx, scaleDownName, &appautoscaling.ScheduledActionArgs{
+		Name:              pulumi.String(scaleDownName),
+		ServiceNamespace:  target.ServiceNamespace,
+		ScalableDimension: target.ScalableDimension,
+		ResourceId:        target.ResourceId,
+		Schedule:          pulumi.String(scheduleExpression(schedule.ScaleDown)),
+		Timezone:          pulumi.StringPtr(schedule.Timezone),
+		ScalableTargetAction: &appautoscaling.ScheduledActionScalableTargetActionArgs{
+			MinCapacity: pulumi.IntPtr(0),
+			MaxCapacity: pulumi.IntPtr(0),
+		},
+	}, m.opts...)
+	if err != nil {
+		return err
+	}
+
+	// Application Auto Scaling rejects concurrent changes to the scheduled actions of a target.
+	scaleUpName := fmt.Sprintf("%s-scale-up", m.baseResourceName)
+	scaleUpOpts := append(m.opts, pulumi.DependsOn([]pulumi.Resource{scaleDown}))
+	_, err = appautoscaling.NewScheduledAction(m.ctx, scaleUpName, &appautoscaling.ScheduledActionArgs{
+		Name:              pulumi.String(scaleUpName),
+		ServiceNamespace:  target.ServiceNamespace,
+		ScalableDimension: target.ScalableDimension,
+		ResourceId:        target.ResourceId,
+		Schedule:          pulumi.String(scheduleExpression(schedule.ScaleUp)),
+		Timezone:          pulumi.StringPtr(schedule.Timezone),
+		ScalableTargetAction: &appautoscaling.ScheduledActionScalableTargetActionArgs{
+			MinCapacity: pulumi.IntPtr(1),
+			MaxCapacity: pulumi.IntPtr(1),
+		},
+	}, scaleUpOpts...)
 	return err
 }
@@ -0,0 +1,56 @@
+package metabase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Schedule stops Metabase outside working hours by scaling the service to zero tasks, and starts
+// it again by scaling it back to one. Both expressions are evaluated in the timezone.
+type Schedule struct {
+	ScaleDown string
+	ScaleUp   string
+	Timezone  string
+}
+
+const DefaultScheduleTimezone = "UTC"
+
+func (s Schedule) Validate() error {
+	if err := validateCronExpression("schedule.scaleDown", s.ScaleDown); err != nil {
+		return err
+	}
+	if err := validateCronExpression("schedule.scaleUp", s.ScaleUp); err != nil {
+		return err
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("schedule.timezone must be an IANA timezone such as \"Europe/London\", got %q", s.Timezone)
+	}
+	return nil
+}
+
+// Application Auto Scaling cron expressions have six fields: minutes, hours, day of month, month,
+// day of week and year, such as `0 19 ? * MON-FRI *`.
+func validateCronExpression(field, expression string) error {
+	if expression == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	if fields := strings.Fields(cronFields(expression)); len(fields) != 6 {
+		return fmt.Errorf("%s must be a cron expression with six fields such as \"0 19 ? * MON-FRI *\", got %q", field, expression)
+	}
+	return nil
+}
+
+// cronFields strips the `cron(...)` wrapper from an expression if it has one.
+func cronFields(expression string) string {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "cron(") && strings.HasSuffix(expression, ")") {
+		return expression[len("cron(") : len(expression)-1]
+	}
+	return expression
+}
+
+// scheduleExpression returns the expression in the `cron(...)` form Application Auto Scaling expects.
+func scheduleExpression(expression string) string {
+	return fmt.Sprintf("cron(%s)", cronFields(expression))
+}
@@ -0,0 +1,91 @@
+package metabase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/rds"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Aurora Serverless v1 only supports Aurora MySQL 2, and RDS Proxy doesn't support Aurora Serverless
+// v1 at all, so MySQL 8.0 compatible clusters and clusters behind a proxy run on Aurora Serverless v2.
+// Serverless v2 needs Aurora MySQL 3.02.0 or later.
+const (
+	serverlessV2InstanceClass = "db.serverless"
+	serverlessV2MinCapacity   = 0.5
+	serverlessV2MaxCapacity   = 16
+)
+
+// RequiresServerlessV2 tells whether the Aurora MySQL version can only run on Aurora Serverless v2.
+func RequiresServerlessV2(engineVersion string) bool {
+	return mysqlVersion(engineVersion) == "8.0"
+}
+
+// ValidateServerlessV2EngineVersion checks the Aurora MySQL version supports Aurora Serverless v2.
+// Partial versions such as `8.0` or `8.0.mysql_aurora.3` are accepted, RDS picks their default
+// version, which is recent enough.
+func ValidateServerlessV2EngineVersion(engineVersion string) error {
+	err := fmt.Errorf("Aurora Serverless v2, which database.useProxy and MySQL 8.0 compatible versions run on, "+
+		"needs database.engineVersion 8.0 or 8.0.mysql_aurora.3.02.0 and later, got %q", engineVersion)
+
+	if engineVersion == "8.0" {
+		return nil
+	}
+	parts := strings.SplitN(engineVersion, ".mysql_aurora.", 2)
+	if len(parts) != 2 || parts[0] != "8.0" {
+		return err
+	}
+	auroraVersion := strings.Split(parts[1], ".")
+	major, majorErr := strconv.Atoi(auroraVersion[0])
+	if majorErr != nil || major < 3 {
+		return err
+	}
+	if major == 3 && len(auroraVersion) > 1 {
+		minor, minorErr := strconv.Atoi(auroraVersion[1])
+		if minorErr != nil || minor < 2 {
+			return err
+		}
+	}
+	return nil
+}
+
+// LookupMySQLCluster returns the application database cluster created by an earlier deployment, or
+// nil when it hasn't been created yet.
+func (m *MetabaseResourceConstructor) LookupMySQLCluster() (*rds.LookupClusterResult, error) {
+	identifier := m.mysqlClusterIdentifier()
+	cluster, err := rds.LookupCluster(m.ctx, &rds.LookupClusterArgs{ClusterIdentifier: identifier})
+	if err != nil {
+		if strings.Contains(err.Error(), "DBClusterNotFoundFault") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("looking up the MySQL cluster %q: %w", identifier, err)
+	}
+	return cluster, nil
+}
+
+// CheckServerlessV2Cluster refuses to move an existing Aurora Serverless v1 cluster to Aurora
+// Serverless v2, since changing the engine mode replaces the cluster and its data. The cluster
+// lookup doesn't return the engine mode, but clusters running Aurora MySQL 2 can't be Serverless v2
+// clusters, so they're the ones the component created on Serverless v1. A nil cluster hasn't been
+// created yet.
+func CheckServerlessV2Cluster(cluster *rds.LookupClusterResult) error {
+	if cluster != nil && !RequiresServerlessV2(cluster.EngineVersion) {
+		return fmt.Errorf("the existing Aurora Serverless v1 cluster %q can't run on Aurora Serverless v2, which "+
+			"database.useProxy and MySQL 8.0 compatible versions need, since changing the engine mode would replace "+
+			"the cluster. Migrate the cluster to Aurora Serverless v2 first", cluster.ClusterIdentifier)
+	}
+	return nil
+}
+
+// NewServerlessV2Instance creates the Aurora Serverless v2 instance of the cluster.
+func (m *MetabaseResourceConstructor) NewServerlessV2Instance(cluster *rds.Cluster) (*rds.ClusterInstance, error) {
+	return rds.NewClusterInstance(m.ctx, m.baseResourceName, &rds.ClusterInstanceArgs{
+		ClusterIdentifier: cluster.ClusterIdentifier,
+		InstanceClass:     pulumi.String(serverlessV2InstanceClass),
+		Engine:            cluster.Engine,
+		EngineVersion:     cluster.EngineVersion,
+		DbSubnetGroupName: cluster.DbSubnetGroupName,
+	}, m.opts...)
+}
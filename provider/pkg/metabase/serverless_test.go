@@ -0,0 +1,33 @@
+package metabase
+
+import "testing"
+
+func TestValidateServerlessV2EngineVersion(t *testing.T) {
+	tests := []struct {
+		engineVersion string
+		wantErr       bool
+	}{
+		{engineVersion: "8.0"},
+		{engineVersion: "8.0.mysql_aurora.3"},
+		{engineVersion: "8.0.mysql_aurora.3.02.0"},
+		{engineVersion: "8.0.mysql_aurora.3.04.0"},
+		{engineVersion: "8.0.mysql_aurora.3.10.1"},
+		{engineVersion: "8.0.mysql_aurora.4.00.0"},
+		{engineVersion: DefaultMySQLEngineVersion},
+		{engineVersion: "8.0.mysql_aurora.3.01.1", wantErr: true},
+		{engineVersion: "8.0.mysql_aurora.2.11.2", wantErr: true},
+		{engineVersion: "5.7.mysql_aurora.2.08.3", wantErr: true},
+		{engineVersion: "5.7", wantErr: true},
+		{engineVersion: "8.0.mysql_aurora.x", wantErr: true},
+		{engineVersion: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.engineVersion, func(t *testing.T) {
+			err := ValidateServerlessV2EngineVersion(tt.engineVersion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateServerlessV2EngineVersion(%q) = %v, want error %v", tt.engineVersion, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package metabase
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type TaskRolePreset string
+
+const (
+	// Query Athena, read the Glue Data Catalog and write query results to the Athena results bucket.
+	AthenaReadOnlyPreset TaskRolePreset = "athena-read-only"
+	// Read any S3 bucket in the account, for example the data behind an Athena table.
+	S3ReadOnlyPreset TaskRolePreset = "s3-read-only"
+)
+
+var taskRolePresets = []TaskRolePreset{AthenaReadOnlyPreset, S3ReadOnlyPreset}
+
+// TaskRolePolicies are the permissions given to the Metabase container itself, as opposed to the
+// execution role which is only used by ECS to start the task.
+type TaskRolePolicies struct {
+	Presets             []string
+	AthenaResultsBucket pulumi.StringInput
+	ManagedPolicyARNs   pulumi.StringArrayInput
+	InlinePolicies      pulumi.StringMapInput
+	// Lets ECS Exec sessions into the container when set.
+	ExecuteCommand *ExecuteCommand
+}
+
+func (p TaskRolePolicies) Validate() error {
+	for _, preset := range p.Presets {
+		if !isTaskRolePreset(preset) {
+			return fmt.Errorf("taskRole.presets contains unknown preset %q, supported presets are %v", preset, taskRolePresets)
+		}
+		if TaskRolePreset(preset) == AthenaReadOnlyPreset && p.AthenaResultsBucket == nil {
+			return fmt.Errorf("taskRole.athenaResultsBucket is required by the %q preset", AthenaReadOnlyPreset)
+		}
+	}
+	return nil
+}
+
+func isTaskRolePreset(preset string) bool {
+	for _, p := range taskRolePresets {
+		if TaskRolePreset(preset) == p {
+			return true
+		}
+	}
+	return false
+}
+
+func (p TaskRolePolicies) hasPreset(preset TaskRolePreset) bool {
+	for _, p := range p.Presets {
+		if TaskRolePreset(p) == preset {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MetabaseResourceConstructor) athenaReadOnlyPolicy(resultsBucket pulumi.StringInput, partition string) pulumi.StringOutput {
+	return iam.GetPolicyDocumentOutput(m.ctx, iam.GetPolicyDocumentOutputArgs{
+		Statements: iam.GetPolicyDocumentStatementArray{
+			iam.GetPolicyDocumentStatementArgs{
+				Actions: pulumi.ToStringArray([]string{
+					"athena:GetDataCatalog",
+					"athena:GetDatabase",
+					"athena:GetQueryExecution",
+					"athena:GetQueryResults",
+					"athena:GetTableMetadata",
+					"athena:GetWorkGroup",
+					"athena:ListDataCatalogs",
+					"athena:ListDatabases",
+					"athena:ListTableMetadata",
+					"athena:ListWorkGroups",
+					"athena:StartQueryExecution",
+					"athena:StopQueryExecution",
+				}),
+				Resources: pulumi.ToStringArray([]string{"*"}),
+			},
+			iam.GetPolicyDocumentStatementArgs{
+				Actions: pulumi.ToStringArray([]string{
+					"glue:GetDatabase",
+					"glue:GetDatabases",
+					"glue:GetPartition",
+					"glue:GetPartitions",
+					"glue:GetTable",
+					"glue:GetTables",
+				}),
+				Resources: pulumi.ToStringArray([]string{"*"}),
+			},
+			iam.GetPolicyDocumentStatementArgs{
+				Actions: pulumi.ToStringArray([]string{
+					"s3:AbortMultipartUpload",
+					"s3:GetBucketLocation",
+					"s3:GetObject",
+					"s3:ListBucket",
+					"s3:ListBucketMultipartUploads",
+					"s3:ListMultipartUploadParts",
+					"s3:PutObject",
+				}),
+				Resources: pulumi.StringArray{
+					pulumi.Sprintf("arn:%s:s3:::%s", partition, resultsBucket),
+					pulumi.Sprintf("arn:%s:s3:::%s/*", partition, resultsBucket),
+				},
+			},
+		},
+	}).Json()
+}
+
+// The name of the inline policy that allows ECS Exec.
+const executeCommandPolicyName = "execute-command"
+
+// taskRoleInlinePolicies merges the preset policies with the user provided ones. The user provided
+// policies can't reuse the name of a preset or of the ECS Exec policy.
+func (m *MetabaseResourceConstructor) taskRoleInlinePolicies(policies TaskRolePolicies, partition string) iam.RoleInlinePolicyArrayOutput {
+	presetPolicies := pulumi.StringMap{}
+	if policies.hasPreset(AthenaReadOnlyPreset) {
+		presetPolicies[string(AthenaReadOnlyPreset)] = m.athenaReadOnlyPolicy(policies.AthenaResultsBucket, partition)
+	}
+	if policies.ExecuteCommand != nil {
+		presetPolicies[executeCommandPolicyName] = m.executeCommandPolicy(*policies.ExecuteCommand, partition)
+	}
+
+	userPolicies := pulumi.StringMap{}.ToStringMapOutput()
+	if policies.InlinePolicies != nil {
+		userPolicies = policies.InlinePolicies.ToStringMapOutput()
+	}
+
+	return pulumi.All(presetPolicies, userPolicies).ApplyT(func(values []interface{}) ([]iam.RoleInlinePolicy, error) {
+		merged := map[string]string{}
+		for name, policy := range values[0].(map[string]string) {
+			merged[name] = policy
+		}
+		for name, policy := range values[1].(map[string]string) {
+			if _, ok := merged[name]; ok {
+				return nil, fmt.Errorf("taskRole.inlinePolicies can't use the name %q, it's reserved by the component", name)
+			}
+			merged[name] = policy
+		}
+
+		names := make([]string, 0, len(merged))
+		for name := range merged {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		result := make([]iam.RoleInlinePolicy, 0, len(names))
+		for _, name := range names {
+			name, policy := name, merged[name]
+			result = append(result, iam.RoleInlinePolicy{Name: &name, Policy: &policy})
+		}
+		return result, nil
+	}).(iam.RoleInlinePolicyArrayOutput)
+}
+
+func taskRoleManagedPolicyARNs(policies TaskRolePolicies, partition string) pulumi.StringArrayOutput {
+	var presetARNs []string
+	if policies.hasPreset(S3ReadOnlyPreset) {
+		presetARNs = append(presetARNs, fmt.Sprintf("arn:%s:iam::aws:policy/AmazonS3ReadOnlyAccess", partition))
+	}
+
+	userARNs := pulumi.StringArray{}.ToStringArrayOutput()
+	if policies.ManagedPolicyARNs != nil {
+		userARNs = policies.ManagedPolicyARNs.ToStringArrayOutput()
+	}
+
+	return userARNs.ApplyT(func(arns []string) []string {
+		return append(presetARNs, arns...)
+	}).(pulumi.StringArrayOutput)
+}
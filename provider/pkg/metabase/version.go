@@ -0,0 +1,113 @@
+package metabase
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Edition string
+
+const (
+	OSSEdition        Edition = "oss"
+	EnterpriseEdition Edition = "enterprise"
+)
+
+// Metabase tags its images `v0.x.y` for the open source edition and `v1.x.y` for the enterprise
+// edition, optionally followed by a patch number such as `v1.46.6.1`.
+var versionTagPattern = regexp.MustCompile(`^v(0|1)\.\d+\.\d+(\.\d+)?$`)
+
+func ValidateEdition(edition string) error {
+	switch Edition(edition) {
+	case OSSEdition, EnterpriseEdition:
+		return nil
+	default:
+		return fmt.Errorf("edition must be one of %q or %q, got %q", OSSEdition, EnterpriseEdition, edition)
+	}
+}
+
+// ImageRepository returns the Docker Hub repository Metabase publishes the edition's images to.
+func (e Edition) ImageRepository() string {
+	if e == EnterpriseEdition {
+		return "metabase/metabase-enterprise"
+	}
+	return "metabase/metabase"
+}
+
+// ValidateVersionTag checks the tag exists in the edition's tag scheme, `latest` is always valid.
+func (e Edition) ValidateVersionTag(tag string) error {
+	if tag == "latest" {
+		return nil
+	}
+
+	match := versionTagPattern.FindStringSubmatch(tag)
+	if match == nil {
+		return fmt.Errorf("metabaseVersion must be `latest` or a version tag such as `v0.46.6`, got %q", tag)
+	}
+
+	major := "0"
+	if e == EnterpriseEdition {
+		major = "1"
+	}
+	if match[1] != major {
+		return fmt.Errorf("metabaseVersion %q isn't a %s edition tag, %s edition tags start with `v%s.`", tag, e, e, major)
+	}
+	return nil
+}
+
+// Version is a Metabase release. The open source `v0.46.6` and enterprise `v1.46.6` tags are the
+// same release, so the leading 0 or 1 isn't part of the version.
+type Version struct {
+	Major  int
+	Minor  int
+	Hotfix int
+}
+
+// ParseVersion parses a version tag such as `v0.46.6` or `v1.46.6.1`, tags like `latest` don't
+// name a version and aren't parsed.
+func ParseVersion(tag string) (Version, bool) {
+	match := versionTagPattern.FindStringSubmatch(tag)
+	if match == nil {
+		return Version{}, false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(tag, "v"), ".")
+	var v Version
+	v.Major, _ = strconv.Atoi(parts[1])
+	v.Minor, _ = strconv.Atoi(parts[2])
+	if len(parts) == 4 {
+		v.Hotfix, _ = strconv.Atoi(parts[3])
+	}
+	return v, true
+}
+
+func (v Version) Less(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Hotfix < other.Hotfix
+}
+
+// CheckUpgrade returns an error when going from the deployed tag to the requested tag is a downgrade.
+// Metabase's application database migrations only run forwards, so an older Metabase can't start
+// against a database that a newer one has migrated. Tags that don't name a version can't be compared.
+func CheckUpgrade(deployedTag, requestedTag string) error {
+	deployed, ok := ParseVersion(deployedTag)
+	if !ok {
+		return nil
+	}
+	requested, ok := ParseVersion(requestedTag)
+	if !ok {
+		return nil
+	}
+	if requested.Less(deployed) {
+		return fmt.Errorf("metabaseVersion %s is older than the deployed version %s, Metabase can't be downgraded "+
+			"once its database has been migrated. Restore a database snapshot taken before the upgrade and set "+
+			"allowDowngrade if you really want to run an older version", requestedTag, deployedTag)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package metabase
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		tag     string
+		version Version
+		ok      bool
+	}{
+		{tag: "v0.46.6", version: Version{Major: 46, Minor: 6}, ok: true},
+		{tag: "v1.46.6", version: Version{Major: 46, Minor: 6}, ok: true},
+		{tag: "v0.46.6.1", version: Version{Major: 46, Minor: 6, Hotfix: 1}, ok: true},
+		{tag: "v1.46.6.12", version: Version{Major: 46, Minor: 6, Hotfix: 12}, ok: true},
+		{tag: "v0.46.10", version: Version{Major: 46, Minor: 10}, ok: true},
+		{tag: "latest", ok: false},
+		{tag: "v2.46.6", ok: false},
+		{tag: "0.46.6", ok: false},
+		{tag: "v0.46", ok: false},
+		{tag: "v0.46.6.1.2", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			version, ok := ParseVersion(tt.tag)
+			if ok != tt.ok || version != tt.version {
+				t.Errorf("ParseVersion(%q) = %+v, %v, want %+v, %v", tt.tag, version, ok, tt.version, tt.ok)
+			}
+		})
+	}
+}
+
+func TestCheckUpgrade(t *testing.T) {
+	tests := []struct {
+		name      string
+		deployed  string
+		requested string
+		wantErr   bool
+	}{
+		{name: "same version", deployed: "v0.46.6", requested: "v0.46.6"},
+		{name: "minor upgrade", deployed: "v0.46.6", requested: "v0.46.7"},
+		{name: "major upgrade", deployed: "v0.46.6", requested: "v0.47.0"},
+		{name: "major downgrade", deployed: "v0.47.0", requested: "v0.46.6", wantErr: true},
+		{name: "minor downgrade", deployed: "v0.46.7", requested: "v0.46.6", wantErr: true},
+		{name: "oss to enterprise of the same release", deployed: "v0.46.6", requested: "v1.46.6"},
+		{name: "enterprise to oss of the same release", deployed: "v1.46.6", requested: "v0.46.6"},
+		{name: "enterprise to an older oss release", deployed: "v1.47.0", requested: "v0.46.6", wantErr: true},
+		{name: "oss to a newer enterprise release", deployed: "v0.46.6", requested: "v1.47.1"},
+		{name: "hotfix upgrade", deployed: "v1.46.6", requested: "v1.46.6.1"},
+		{name: "hotfix downgrade", deployed: "v1.46.6.1", requested: "v1.46.6", wantErr: true},
+		{name: "hotfix to the next minor", deployed: "v1.46.6.4", requested: "v1.46.7"},
+		{name: "minor 9 to 10", deployed: "v0.46.9", requested: "v0.46.10"},
+		{name: "minor 10 to 9", deployed: "v0.46.10", requested: "v0.46.9", wantErr: true},
+		{name: "major 9 to 10", deployed: "v0.9.0", requested: "v0.10.0"},
+		{name: "major 10 to 9", deployed: "v0.10.0", requested: "v0.9.0", wantErr: true},
+		{name: "latest requested", deployed: "v0.47.0", requested: "latest"},
+		{name: "latest deployed", deployed: "latest", requested: "v0.46.6"},
+		{name: "nothing deployed", deployed: "", requested: "v0.46.6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckUpgrade(tt.deployed, tt.requested)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckUpgrade(%q, %q) = %v, want error %v", tt.deployed, tt.requested, err, tt.wantErr)
+			}
+		})
+	}
+}
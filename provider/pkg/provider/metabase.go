@@ -15,13 +15,18 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/acm"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ec2"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/ecr"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/lb"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/rds"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/route53"
 	"github.com/pulumi/pulumi-metabase/pkg/metabase"
@@ -47,17 +52,120 @@ type Networking struct {
 }
 
 type Database struct {
+	Engine        *string            `pulumi:"engine"`
 	EngineVersion pulumi.StringInput `pulumi:"engineVersion"`
+	UseProxy      *bool              `pulumi:"useProxy"`
+}
+
+type HealthCheck struct {
+	Interval           *int `pulumi:"interval"`
+	Timeout            *int `pulumi:"timeout"`
+	HealthyThreshold   *int `pulumi:"healthyThreshold"`
+	UnhealthyThreshold *int `pulumi:"unhealthyThreshold"`
+	StartPeriod        *int `pulumi:"startPeriod"`
+}
+
+type FireLens struct {
+	Image         pulumi.StringInput    `pulumi:"image"`
+	Options       pulumi.StringMapInput `pulumi:"options"`
+	SecretOptions pulumi.StringMapInput `pulumi:"secretOptions"`
+}
+
+type Logging struct {
+	RetentionInDays *int               `pulumi:"retentionInDays"`
+	KMSKeyID        pulumi.StringInput `pulumi:"kmsKeyId"`
+	FireLens        *FireLens          `pulumi:"firelens"`
+}
+
+type TaskRole struct {
+	Presets             []string                `pulumi:"presets"`
+	AthenaResultsBucket pulumi.StringInput      `pulumi:"athenaResultsBucket"`
+	ManagedPolicyARNs   pulumi.StringArrayInput `pulumi:"managedPolicyArns"`
+	InlinePolicies      pulumi.StringMapInput   `pulumi:"inlinePolicies"`
+}
+
+type ImageMirror struct {
+	Type       *string `pulumi:"type"`
+	ScanOnPush *bool   `pulumi:"scanOnPush"`
+}
+
+type Compute struct {
+	Architecture *string `pulumi:"architecture"`
+	Capacity     *string `pulumi:"capacity"`
+}
+
+type Cluster struct {
+	ContainerInsights               *bool                      `pulumi:"containerInsights"`
+	Tags                            pulumi.StringMapInput      `pulumi:"tags"`
+	DefaultCapacityProviderStrategy []CapacityProviderStrategy `pulumi:"defaultCapacityProviderStrategy"`
+}
+
+type CapacityProviderStrategy struct {
+	CapacityProvider string `pulumi:"capacityProvider"`
+	Weight           *int   `pulumi:"weight"`
+	Base             *int   `pulumi:"base"`
+}
+
+type Schedule struct {
+	ScaleDown string  `pulumi:"scaleDown"`
+	ScaleUp   string  `pulumi:"scaleUp"`
+	Timezone  *string `pulumi:"timezone"`
+}
+
+type ExecuteCommand struct {
+	KMSKeyID     pulumi.StringInput `pulumi:"kmsKeyId"`
+	LogGroupName pulumi.StringInput `pulumi:"logGroupName"`
+	S3BucketName pulumi.StringInput `pulumi:"s3BucketName"`
+	S3KeyPrefix  pulumi.StringInput `pulumi:"s3KeyPrefix"`
+}
+
+type Plugin struct {
+	URL    string `pulumi:"url"`
+	SHA256 string `pulumi:"sha256"`
 }
 
 type MetabaseArgs struct {
 	VpcID           pulumi.StringInput `pulumi:"vpcId"`
 	MetabaseVersion pulumi.StringInput `pulumi:"metabaseVersion"`
 
+	// Shared infrastructure
+	ECSClusterARN pulumi.StringInput `pulumi:"ecsClusterArn"`
+	Cluster       *Cluster           `pulumi:"cluster"`
+
+	// Metabase Enterprise
+	Edition               *string            `pulumi:"edition"`
+	PremiumEmbeddingToken pulumi.StringInput `pulumi:"premiumEmbeddingToken"`
+
+	// Custom images
+	Image                 pulumi.StringInput `pulumi:"image"`
+	RepositoryCredentials pulumi.StringInput `pulumi:"repositoryCredentials"`
+	ImageMirror           *ImageMirror       `pulumi:"imageMirror"`
+	PinImageDigest        *bool              `pulumi:"pinImageDigest"`
+
+	// Upgrades
+	AllowDowngrade *bool `pulumi:"allowDowngrade"`
+
 	// Additional args
-	Domain   CustomDomain `pulumi:"domain"`
-	Network  Networking   `pulumi:"networking"`
-	Database Database     `pulumi:"database"`
+	Domain      CustomDomain `pulumi:"domain"`
+	Network     Networking   `pulumi:"networking"`
+	Database    Database     `pulumi:"database"`
+	HealthCheck HealthCheck  `pulumi:"healthCheck"`
+	Logging     Logging      `pulumi:"logging"`
+	TaskRole    TaskRole     `pulumi:"taskRole"`
+	Settings    Settings     `pulumi:"settings"`
+	Compute     Compute      `pulumi:"compute"`
+	Schedule    *Schedule    `pulumi:"schedule"`
+
+	// Debugging
+	EnableExecuteCommand *bool           `pulumi:"enableExecuteCommand"`
+	ExecuteCommand       *ExecuteCommand `pulumi:"executeCommand"`
+
+	// Additional container configuration
+	Environment pulumi.StringMapInput `pulumi:"environment"`
+	Secrets     pulumi.StringMapInput `pulumi:"secrets"`
+	Sidecars    []Sidecar             `pulumi:"sidecars"`
+	Plugins     []Plugin              `pulumi:"plugins"`
+	Hardened    *bool                 `pulumi:"hardened"`
 }
 
 type Metabase struct {
@@ -65,6 +173,10 @@ type Metabase struct {
 
 	DNSName         pulumi.StringOutput `pulumi:"dnsName"`
 	SecurityGroupID pulumi.StringOutput `pulumi:"securityGroupId"`
+	LogGroupName    pulumi.StringOutput `pulumi:"logGroupName"`
+	TaskRoleARN     pulumi.StringOutput `pulumi:"taskRoleArn"`
+	ImageRepository pulumi.StringOutput `pulumi:"imageRepository"`
+	ImageDigest     pulumi.StringOutput `pulumi:"imageDigest"`
 }
 
 func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...pulumi.ResourceOption) (*Metabase, error) {
@@ -82,8 +194,269 @@ func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...p
 
 	attachDomainName := args.Domain.DomainName != nil && args.Domain.HostedZoneName != nil
 
+	healthCheck, err := newHealthCheck(args.HealthCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	logRetentionInDays := metabase.DefaultLogRetentionInDays
+	if args.Logging.RetentionInDays != nil {
+		logRetentionInDays = *args.Logging.RetentionInDays
+	}
+	if err := metabase.ValidateLogRetention(logRetentionInDays); err != nil {
+		return nil, err
+	}
+
+	edition := metabase.OSSEdition
+	if args.Edition != nil {
+		if err := metabase.ValidateEdition(*args.Edition); err != nil {
+			return nil, err
+		}
+		edition = metabase.Edition(*args.Edition)
+	}
+
+	// The runtime platform is only set when an architecture is chosen, so existing task definitions aren't replaced.
+	var architecture metabase.Architecture
+	if args.Compute.Architecture != nil {
+		if err := metabase.ValidateArchitecture(*args.Compute.Architecture); err != nil {
+			return nil, err
+		}
+		architecture = metabase.Architecture(*args.Compute.Architecture)
+	}
+
+	// Likewise the service keeps the FARGATE launch type unless a capacity is chosen.
+	var capacity metabase.Capacity
+	if args.Compute.Capacity != nil {
+		if err := metabase.ValidateCapacity(*args.Compute.Capacity); err != nil {
+			return nil, err
+		}
+		capacity = metabase.Capacity(*args.Compute.Capacity)
+	}
+	if capacity.UsesSpot() && architecture == metabase.ARM64Architecture {
+		return nil, fmt.Errorf("compute.capacity %q can't be used with compute.architecture %q, Fargate Spot doesn't run ARM64 tasks", capacity, architecture)
+	}
+
+	var schedule *metabase.Schedule
+	if args.Schedule != nil {
+		schedule = &metabase.Schedule{
+			ScaleDown: args.Schedule.ScaleDown,
+			ScaleUp:   args.Schedule.ScaleUp,
+			Timezone:  metabase.DefaultScheduleTimezone,
+		}
+		if args.Schedule.Timezone != nil {
+			schedule.Timezone = *args.Schedule.Timezone
+		}
+		if err := schedule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if args.ImageMirror != nil && args.ImageMirror.Type != nil {
+		if err := metabase.ValidateImageMirror(*args.ImageMirror.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	if args.Image != nil && args.ImageMirror != nil {
+		return nil, fmt.Errorf("image and imageMirror can't be used together, push the custom image to its own registry instead")
+	}
+	if args.RepositoryCredentials != nil && args.Image == nil {
+		return nil, fmt.Errorf("repositoryCredentials requires a custom image")
+	}
+
+	environment, err := knownStringMap("environment", args.Environment)
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := knownStringMap("secrets", args.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateContainerEnvironment(environment, secrets); err != nil {
+		return nil, err
+	}
+
+	if args.PremiumEmbeddingToken != nil {
+		if edition != metabase.EnterpriseEdition {
+			return nil, fmt.Errorf("premiumEmbeddingToken requires the %q edition", metabase.EnterpriseEdition)
+		}
+		if err := validateManagedVariable(premiumEmbeddingTokenVariable, environment, secrets); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateSidecars(args.Sidecars); err != nil {
+		return nil, err
+	}
+
+	plugins := make([]metabase.Plugin, 0, len(args.Plugins))
+	for _, plugin := range args.Plugins {
+		plugins = append(plugins, metabase.Plugin{URL: plugin.URL, SHA256: plugin.SHA256})
+	}
+	if err := metabase.ValidatePlugins(plugins); err != nil {
+		return nil, err
+	}
+	hardened := args.Hardened != nil && *args.Hardened
+	// Metabase extracts its bundled drivers into the plugins directory, which has to be writable.
+	usePluginsVolume := len(plugins) > 0 || hardened
+	if usePluginsVolume {
+		if err := validateManagedVariable(pluginsDirVariable, environment, secrets); err != nil {
+			return nil, err
+		}
+	}
+
+	databaseEngine := metabase.MySQLDatabaseEngine
+	if args.Database.Engine != nil {
+		if err := metabase.ValidateDatabaseEngine(*args.Database.Engine); err != nil {
+			return nil, err
+		}
+		databaseEngine = metabase.DatabaseEngine(*args.Database.Engine)
+	}
+	if databaseEngine == metabase.H2DatabaseEngine {
+		// database.engineVersion used to have a default in the SDKs, so it's ignored rather than rejected.
+		_ = ctx.Log.Warn("database.engine h2 stores the Metabase application database in an H2 file on EFS, "+
+			"which Metabase doesn't support in production. Only use it for sandboxes.", &pulumi.LogArgs{Resource: component})
+	}
+
+	useProxy := args.Database.UseProxy != nil && *args.Database.UseProxy
+	if useProxy && databaseEngine != metabase.MySQLDatabaseEngine {
+		return nil, fmt.Errorf("database.useProxy can only be used with the mysql engine")
+	}
+
+	// The plugins, the H2 file and the writable paths of a hardened container are stored on EFS, which
+	// needs a mount target in each of the task's subnets.
+	useFileSystem := usePluginsVolume || databaseEngine == metabase.H2DatabaseEngine
+	// The default subnet lookup always returns two subnets.
+	fileSystemSubnetCount := 2
+	if useFileSystem && args.Network.ECSSubnetIDs != nil {
+		subnetIDs, ok := args.Network.ECSSubnetIDs.(pulumi.StringArray)
+		if !ok {
+			return nil, fmt.Errorf("networking.ecsSubnetIds must be known before deployment to create the EFS mount targets")
+		}
+		fileSystemSubnetCount = len(subnetIDs)
+	}
+
+	if err := args.Settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	containerEnvironment, err := withSettings(args.Settings, environment, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	var executeCommand *metabase.ExecuteCommand
+	if args.EnableExecuteCommand != nil && *args.EnableExecuteCommand {
+		executeCommand = &metabase.ExecuteCommand{}
+		if args.ExecuteCommand != nil {
+			if args.ExecuteCommand.S3KeyPrefix != nil && args.ExecuteCommand.S3BucketName == nil {
+				return nil, fmt.Errorf("executeCommand.s3KeyPrefix requires executeCommand.s3BucketName")
+			}
+			executeCommand = &metabase.ExecuteCommand{
+				KMSKeyID:     args.ExecuteCommand.KMSKeyID,
+				LogGroupName: args.ExecuteCommand.LogGroupName,
+				S3BucketName: args.ExecuteCommand.S3BucketName,
+				S3KeyPrefix:  args.ExecuteCommand.S3KeyPrefix,
+			}
+		}
+	} else if args.ExecuteCommand != nil {
+		return nil, fmt.Errorf("executeCommand requires enableExecuteCommand")
+	}
+	if hardened && executeCommand != nil {
+		return nil, fmt.Errorf("hardened can't be used with enableExecuteCommand, ECS Exec needs a writable root filesystem")
+	}
+	if args.ECSClusterARN != nil && args.ExecuteCommand != nil {
+		return nil, fmt.Errorf("executeCommand can't be used with ecsClusterArn, ECS Exec encryption and logging are configured on the existing cluster")
+	}
+	if args.ECSClusterARN != nil && args.Compute.Capacity != nil {
+		return nil, fmt.Errorf("compute.capacity can't be used with ecsClusterArn, the capacity providers of an existing cluster " +
+			"are left to its owner and can't be checked before deployment")
+	}
+
+	var clusterSettings *metabase.ClusterSettings
+	if args.Cluster != nil {
+		if args.ECSClusterARN != nil {
+			return nil, fmt.Errorf("cluster can't be used with ecsClusterArn, the settings of an existing cluster are left to its owner")
+		}
+		clusterSettings = &metabase.ClusterSettings{
+			ContainerInsights: args.Cluster.ContainerInsights,
+			Tags:              args.Cluster.Tags,
+		}
+		for _, strategy := range args.Cluster.DefaultCapacityProviderStrategy {
+			item := metabase.CapacityProviderStrategy{CapacityProvider: strategy.CapacityProvider}
+			if strategy.Weight != nil {
+				item.Weight = *strategy.Weight
+			}
+			if strategy.Base != nil {
+				item.Base = *strategy.Base
+			}
+			clusterSettings.DefaultCapacityProviderStrategy = append(clusterSettings.DefaultCapacityProviderStrategy, item)
+		}
+		if err := clusterSettings.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	taskRolePolicies := metabase.TaskRolePolicies{
+		Presets:             args.TaskRole.Presets,
+		AthenaResultsBucket: args.TaskRole.AthenaResultsBucket,
+		ManagedPolicyARNs:   args.TaskRole.ManagedPolicyARNs,
+		InlinePolicies:      args.TaskRole.InlinePolicies,
+		ExecuteCommand:      executeCommand,
+	}
+	if err := taskRolePolicies.Validate(); err != nil {
+		return nil, err
+	}
+
 	metabaseBuilder := metabase.NewMetabaseResourceConstructor(ctx, name, opts...)
 
+	var clusterARN pulumi.StringInput
+	if args.ECSClusterARN != nil {
+		clusterARN = metabaseBuilder.CheckExistingCluster(args.ECSClusterARN)
+	}
+
+	// MySQL 8.0 compatible versions and clusters behind a proxy run on Aurora Serverless v2. New clusters get a
+	// MySQL 8.0 compatible version by default, while clusters created with the former MySQL 5.7 default keep it
+	// so they aren't replaced. The engine mode has to be known when planning, so versions that depend on other
+	// resources run on Aurora Serverless v1 unless the cluster is behind a proxy.
+	var engineVersion pulumi.StringInput
+	serverlessV2 := useProxy
+	legacyCluster := false
+	if databaseEngine == metabase.MySQLDatabaseEngine {
+		existingCluster, err := metabaseBuilder.LookupMySQLCluster()
+		if err != nil {
+			return nil, err
+		}
+		legacyCluster = existingCluster != nil && !metabase.RequiresServerlessV2(existingCluster.EngineVersion)
+
+		engineVersion = args.Database.EngineVersion
+		if engineVersion == nil {
+			engineVersion = pulumi.String(metabase.DefaultMySQLEngineVersion)
+			if legacyCluster {
+				engineVersion = pulumi.String(metabase.LegacyMySQLEngineVersion)
+			}
+		}
+
+		knownEngineVersion, ok := engineVersion.(pulumi.String)
+		switch {
+		case ok:
+			serverlessV2 = serverlessV2 || metabase.RequiresServerlessV2(string(knownEngineVersion))
+			if serverlessV2 {
+				if err := metabase.ValidateServerlessV2EngineVersion(string(knownEngineVersion)); err != nil {
+					return nil, err
+				}
+			}
+		case !useProxy:
+			_ = ctx.Log.Warn("database.engineVersion isn't known until other resources are created, the cluster "+
+				"runs on Aurora Serverless v1, which only supports MySQL 5.7 compatible versions", &pulumi.LogArgs{Resource: component})
+		}
+		if serverlessV2 {
+			if err := metabase.CheckServerlessV2Cluster(existingCluster); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	vpcID := args.VpcID
 	if vpcID == nil {
 		vpc, err := ec2.NewDefaultVpc(ctx, name, &ec2.DefaultVpcArgs{}, opts...)
@@ -172,16 +545,43 @@ func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...p
 		return nil, errors.Wrap(err, "Creating Security Group Rules")
 	}
 
-	// Create a password for the MySQL cluster.
-	metabasePassword, err := metabaseBuilder.NewMetabasePassword()
-	if err != nil {
-		return nil, errors.Wrap(err, "Creating Metabase Password")
-	}
+	// The MySQL cluster isn't needed when the application database is an H2 file.
+	var metabaseMysqlCluster *rds.Cluster
+	databaseEnvironment := h2Environment()
+	if databaseEngine == metabase.MySQLDatabaseEngine {
+		// Create a password for the MySQL cluster.
+		metabasePassword, err := metabaseBuilder.NewMetabasePassword()
+		if err != nil {
+			return nil, errors.Wrap(err, "Creating Metabase Password")
+		}
 
-	// Create the MySQL cluster.
-	metabaseMysqlCluster, err := metabaseBuilder.NewMySQLCluster(dbSubnetIDs, metabasePassword, metabaseSecurityGroup.ID(), args.Database.EngineVersion)
-	if err != nil {
-		return nil, errors.Wrap(err, "Creating MySQL Cluster")
+		// Create the MySQL cluster.
+		metabaseMysqlCluster, err = metabaseBuilder.NewMySQLCluster(dbSubnetIDs, metabasePassword, metabaseSecurityGroup.ID(), engineVersion, schedule != nil, serverlessV2)
+		if err != nil {
+			return nil, errors.Wrap(err, "Creating MySQL Cluster")
+		}
+
+		// An Aurora Serverless v2 cluster only accepts connections once its instance is available.
+		databaseHost := metabaseMysqlCluster.Endpoint
+		var instance *rds.ClusterInstance
+		if serverlessV2 {
+			instance, err = metabaseBuilder.NewServerlessV2Instance(metabaseMysqlCluster)
+			if err != nil {
+				return nil, errors.Wrap(err, "Creating MySQL Cluster Instance")
+			}
+			databaseHost = pulumi.All(metabaseMysqlCluster.Endpoint, instance.ID()).ApplyT(func(values []interface{}) string {
+				return values[0].(string)
+			}).(pulumi.StringOutput)
+		}
+
+		// Connect through RDS Proxy so new tasks reuse pooled database connections.
+		if useProxy {
+			databaseHost, err = metabaseBuilder.NewDatabaseProxy(metabaseMysqlCluster, instance, dbSubnetIDs, metabaseSecurityGroup.ID())
+			if err != nil {
+				return nil, errors.Wrap(err, "Creating Database Proxy")
+			}
+		}
+		databaseEnvironment = mysqlEnvironment(metabaseMysqlCluster, databaseHost)
 	}
 
 	var certificate *acm.Certificate
@@ -198,7 +598,7 @@ func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...p
 
 	loadBalancer, targetGroup, lbListener, err := metabaseBuilder.NewLoadBalancer(
 		vpcID, lbSubnetIDs, loadBalancerSecurityGroup.ID(), metabasePort,
-		certificateValidation, certificate, attachDomainName,
+		certificateValidation, certificate, attachDomainName, healthCheck,
 	)
 	if err != nil {
 		return nil, err
@@ -206,22 +606,335 @@ func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...p
 
 	regionName := aws.GetRegionOutput(ctx, aws.GetRegionOutputArgs{}, pulumi.Parent(component)).Name()
 
-	metabaseImageName := pulumi.String("metabase/metabase:latest").ToStringOutput()
+	// The tag of the official image, checked against the edition's tag scheme.
+	imageTag := pulumi.String("latest").ToStringOutput()
 	if args.MetabaseVersion != nil {
-		metabaseImageName = pulumi.Sprintf("metabase/metabase:%s", args.MetabaseVersion)
+		imageTag = args.MetabaseVersion.ToStringOutput().ApplyT(func(tag string) (string, error) {
+			if err := edition.ValidateVersionTag(tag); err != nil {
+				return "", err
+			}
+			return tag, nil
+		}).(pulumi.StringOutput)
 	}
 
-	metabaseContainerDef := newMetabaseContainer(*metabaseMysqlCluster, metabaseImageName, regionName)
+	// Make sure the Metabase version supports the application database engine version. Clusters created with
+	// the former MySQL 5.7 default that still run an unpinned `latest` keep deploying with a warning, since
+	// failing would break stacks that never chose either version.
+	if metabaseMysqlCluster != nil {
+		imageTag = pulumi.All(imageTag, engineVersion).ApplyT(func(values []interface{}) (string, error) {
+			tag, engineVersion := values[0].(string), values[1].(string)
+			err := metabase.CheckDatabaseCompatibility(tag, metabase.AuroraMySQLEngine, engineVersion)
+			switch {
+			case err == nil:
+			case tag == "latest" && legacyCluster:
+				_ = ctx.Log.Warn(fmt.Sprintf("%s. Pin metabaseVersion to a release older than v0.47 or migrate the "+
+					"cluster to an Aurora MySQL 3 version", err), &pulumi.LogArgs{Resource: component})
+			default:
+				return "", err
+			}
+			return tag, nil
+		}).(pulumi.StringOutput)
+	}
 
-	err = metabaseBuilder.NewMetabaseService(
-		args.MetabaseVersion, regionName, metabaseContainerDef, ecsSubnetIDs,
-		metabaseSecurityGroup.ID(), metabasePort, targetGroup.Arn, lbListener,
-		ecsAssignPublicIP,
-	)
+	// Refuse to go back to an older Metabase than the one last deployed, unless explicitly allowed.
+	deployedVersion, deployedDigest, err := metabaseBuilder.LookupDeployedVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "Looking up the deployed Metabase version")
+	}
+	if args.AllowDowngrade == nil || !*args.AllowDowngrade {
+		imageTag = imageTag.ApplyT(func(tag string) (string, error) {
+			if err := metabase.CheckUpgrade(deployedVersion, tag); err != nil {
+				return "", err
+			}
+			// A deployed tag such as `latest` doesn't say which release it was, so moving from it to a
+			// version can't be checked.
+			if _, ok := metabase.ParseVersion(deployedVersion); !ok && deployedVersion != "" && deployedVersion != tag {
+				if _, ok := metabase.ParseVersion(tag); ok {
+					_ = ctx.Log.Warn(fmt.Sprintf("the deployed metabaseVersion %s doesn't name a release, so %s can't be "+
+						"checked for a downgrade. Metabase can't be downgraded once its database has been migrated, make sure "+
+						"%s isn't older than the release %s pointed to", deployedVersion, tag, tag, deployedVersion), &pulumi.LogArgs{Resource: component})
+				}
+			}
+			return tag, nil
+		}).(pulumi.StringOutput)
+	}
+
+	// Pin the image to the digest its tag currently points to, so every task runs the same image and a
+	// change to the tag shows up as a diff. Images behind registry credentials can't be looked up.
+	pinImageDigest := (args.PinImageDigest == nil || *args.PinImageDigest) && args.RepositoryCredentials == nil
+
+	// Snapshot the application database before Metabase migrates it to a new version. Whether the version
+	// changes has to be known when planning, so versions that depend on other resources aren't snapshotted.
+	// A tag such as `latest` moving to a new image is an upgrade too, which is only noticed when the image
+	// is pinned since the digest of the deployed image isn't known otherwise.
+	var serviceDependencies []pulumi.Resource
+	if deployedVersion != "" && metabaseMysqlCluster != nil {
+		requestedVersion := "latest"
+		knownVersion := true
+		if args.MetabaseVersion != nil {
+			version, ok := args.MetabaseVersion.(pulumi.String)
+			requestedVersion, knownVersion = string(version), ok
+		}
+
+		fromVersion, toVersion := deployedVersion, requestedVersion
+		if knownVersion && requestedVersion == deployedVersion && deployedDigest != "" && pinImageDigest && args.Image == nil {
+			digest, err := resolveImageDigest(fmt.Sprintf("%s:%s", edition.ImageRepository(), requestedVersion))
+			if err != nil {
+				return nil, errors.Wrap(err, "Resolving the Metabase image digest")
+			}
+			if digest != deployedDigest {
+				fromVersion = fmt.Sprintf("%s-%s", deployedVersion, metabase.ShortDigest(deployedDigest))
+				toVersion = fmt.Sprintf("%s-%s", requestedVersion, metabase.ShortDigest(digest))
+			}
+		}
+
+		switch {
+		case !knownVersion:
+			_ = ctx.Log.Warn("metabaseVersion isn't known until other resources are created, "+
+				"the database won't be snapshotted before an upgrade", &pulumi.LogArgs{Resource: component})
+		case fromVersion != toVersion:
+			snapshot, err := metabaseBuilder.NewPreUpgradeSnapshot(metabaseMysqlCluster, fromVersion, toVersion)
+			if err != nil {
+				return nil, errors.Wrap(err, "Creating Pre-Upgrade Database Snapshot")
+			}
+			serviceDependencies = append(serviceDependencies, snapshot)
+		}
+	}
+
+	// Pull the image from Docker Hub unless it's mirrored in ECR.
+	imageRepository := pulumi.String(edition.ImageRepository()).ToStringOutput()
+	var mirrorRepository *metabase.ImageRepository
+	if args.ImageMirror != nil {
+		scanOnPush := true
+		if args.ImageMirror.ScanOnPush != nil {
+			scanOnPush = *args.ImageMirror.ScanOnPush
+		}
+		mirrorRepository, err = metabaseBuilder.NewImageRepository(scanOnPush)
+		if err != nil {
+			return nil, errors.Wrap(err, "Creating Image Repository")
+		}
+		imageRepository = mirrorRepository.RepositoryURL
+	}
+
+	// The official image, which the mirror is a copy of.
+	upstreamImage := pulumi.Sprintf("%s:%s", edition.ImageRepository(), imageTag)
+	metabaseImageName := pulumi.Sprintf("%s:%s", imageRepository, imageTag)
+	if args.Image != nil {
+		// A custom image is used as is, the tag from metabaseVersion isn't applied.
+		metabaseImageName = args.Image.ToStringOutput()
+		imageRepository = metabaseImageName.ApplyT(imageRepositoryName).(pulumi.StringOutput)
+	}
+
+	// Make sure the image is published for the chosen architecture. The mirror is checked against the
+	// upstream image, and images behind registry credentials or in a private ECR registry can't be looked up.
+	if architecture != "" && args.RepositoryCredentials == nil {
+		architectureSourceImage := metabaseImageName
+		if mirrorRepository != nil {
+			architectureSourceImage = upstreamImage
+		}
+		metabaseImageName = pulumi.All(metabaseImageName, architectureSourceImage).ApplyT(func(values []interface{}) (string, error) {
+			image, sourceImage := values[0].(string), values[1].(string)
+			if _, _, isECR := metabase.ParseImageReference(sourceImage).ECRRegistry(); isECR {
+				_ = ctx.Log.Warn(fmt.Sprintf("%q is in a private ECR registry, it can't be checked for compute.architecture %s",
+					sourceImage, architecture), &pulumi.LogArgs{Resource: component})
+				return image, nil
+			}
+			if err := metabase.CheckImageArchitecture(context.Background(), sourceImage, architecture); err != nil {
+				return "", err
+			}
+			return image, nil
+		}).(pulumi.StringOutput)
+	}
+
+	imageDigest := pulumi.String("").ToStringOutput()
+	if pinImageDigest {
+		if mirrorRepository != nil {
+			// The mirror holds a byte for byte copy of the upstream image, so both have the same digest.
+			imageDigest = upstreamImage.ApplyT(resolveImageDigest).(pulumi.StringOutput)
+		} else {
+			imageDigest = pulumi.All(metabaseImageName, regionName).ApplyT(func(values []interface{}) (string, error) {
+				return lookupImageDigest(ctx, component, values[0].(string), values[1].(string))
+			}).(pulumi.StringOutput)
+		}
+		// The pinned reference is built from the checked image name, so a failed architecture check
+		// fails the deployment whichever image the digest was looked up from.
+		metabaseImageName = pulumi.All(metabaseImageName, imageRepository, imageDigest).ApplyT(func(values []interface{}) string {
+			image, repository, digest := values[0].(string), values[1].(string), values[2].(string)
+			if digest == "" {
+				return image
+			}
+			return fmt.Sprintf("%s@%s", repository, digest)
+		}).(pulumi.StringOutput)
+	}
+
+	// Copy the upstream image to the mirror before the tasks pull it. A pinned image is copied by its
+	// digest so the mirror holds exactly the pinned image. Nothing is copied when previewing.
+	if mirrorRepository != nil {
+		metabaseImageName = pulumi.All(metabaseImageName, upstreamImage, imageDigest, mirrorRepository.RegistryID, mirrorRepository.RepositoryURL, imageTag).ApplyT(func(values []interface{}) (string, error) {
+			image, sourceImage, digest := values[0].(string), values[1].(string), values[2].(string)
+			registryID, repositoryURL, tag := values[3].(string), values[4].(string), values[5].(string)
+			if ctx.DryRun() {
+				return image, nil
+			}
+
+			token, err := ecr.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenArgs{RegistryId: &registryID})
+			if err != nil {
+				return "", errors.Wrap(err, "Getting ECR Authorization Token")
+			}
+			if digest != "" {
+				sourceImage = fmt.Sprintf("%s@%s", sourceImage, digest)
+			}
+			destinationImage := fmt.Sprintf("%s:%s", repositoryURL, tag)
+			if err := metabase.CopyImage(context.Background(), sourceImage, destinationImage, token.AuthorizationToken); err != nil {
+				return "", err
+			}
+			return image, nil
+		}).(pulumi.StringOutput)
+	}
+
+	containerSecrets := make(map[string]pulumi.StringInput, len(secrets))
+	for name, arn := range secrets {
+		containerSecrets[name] = arn
+	}
+	if args.PremiumEmbeddingToken != nil {
+		tokenSecretARN, err := metabaseBuilder.NewPremiumEmbeddingTokenSecret(args.PremiumEmbeddingToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "Creating Premium Embedding Token Secret")
+		}
+		containerSecrets[premiumEmbeddingTokenVariable] = tokenSecretARN
+	}
+
+	// Let Metabase know the URL it's served on so emails, alerts and embeds link to the right place,
+	// unless the user has set it themselves.
+	if _, ok := containerEnvironment["MB_SITE_URL"]; !ok {
+		if _, ok := secrets["MB_SITE_URL"]; !ok {
+			containerEnvironment["MB_SITE_URL"] = defaultSiteURL(args.Domain, attachDomainName, loadBalancer)
+		}
+	}
+
+	var volumes []metabase.EFSVolume
+	if useFileSystem {
+		fileSystem, err := metabaseBuilder.NewFileSystem(ecsSubnetIDs, fileSystemSubnetCount, metabaseSecurityGroup.ID())
+		if err != nil {
+			return nil, errors.Wrap(err, "Creating EFS File System")
+		}
+		serviceDependencies = append(serviceDependencies, fileSystem.MountTargets...)
+
+		if databaseEngine == metabase.H2DatabaseEngine {
+			dataVolume, err := metabaseBuilder.NewVolume(fileSystem, metabase.H2DataVolumeName, metabase.H2DataDir)
+			if err != nil {
+				return nil, errors.Wrap(err, "Creating H2 Data Volume")
+			}
+			volumes = append(volumes, dataVolume)
+		}
+
+		if usePluginsVolume {
+			pluginsVolume, err := metabaseBuilder.NewVolume(fileSystem, metabase.PluginsVolumeName, metabase.PluginsDir)
+			if err != nil {
+				return nil, errors.Wrap(err, "Creating Plugins Volume")
+			}
+			volumes = append(volumes, pluginsVolume)
+			containerEnvironment[pluginsDirVariable] = pulumi.String(metabase.PluginsDir)
+		}
+
+		if hardened {
+			tmpVolume, err := metabaseBuilder.NewVolume(fileSystem, metabase.TmpVolumeName, metabase.TmpDir)
+			if err != nil {
+				return nil, errors.Wrap(err, "Creating Tmp Volume")
+			}
+			volumes = append(volumes, tmpVolume)
+		}
+	}
+
+	metabaseLogGroup, err := metabaseBuilder.NewLogGroup(logRetentionInDays, args.Logging.KMSKeyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Creating Log Group")
+	}
+
+	stopTimeout := 0
+	if hardened {
+		stopTimeout = metabase.HardenedStopTimeout
+	}
+	if capacity.UsesSpot() {
+		stopTimeout = metabase.SpotStopTimeout
+	}
+
+	metabaseContainerDef := newMetabaseContainer(metabaseContainerArgs{
+		databaseEnvironment: databaseEnvironment,
+		imageName:           metabaseImageName,
+		regionName:          regionName,
+		logGroupName:        metabaseLogGroup.Name,
+		healthCheck:         healthCheck,
+		fireLens:            args.Logging.FireLens,
+		environment:         containerEnvironment,
+		secrets:             containerSecrets,
+
+		customImage:           args.Image != nil,
+		repositoryCredentials: args.RepositoryCredentials,
+		stopTimeout:           stopTimeout,
+		sidecars:              args.Sidecars,
+		plugins:               plugins,
+		h2:                    databaseEngine == metabase.H2DatabaseEngine,
+		hardened:              hardened,
+	})
+
+	metabaseTaskRole, err := metabaseBuilder.NewECSTaskRole(taskRolePolicies)
+	if err != nil {
+		return nil, errors.Wrap(err, "Creating ECS Task Role")
+	}
+
+	// Secrets Manager and SSM Parameter Store ARNs that ECS needs to read when starting the task.
+	var taskSecrets []pulumi.StringMapInput
+	if len(containerSecrets) > 0 {
+		taskSecrets = append(taskSecrets, pulumi.StringMap(containerSecrets))
+	}
+	if args.Logging.FireLens != nil && args.Logging.FireLens.SecretOptions != nil {
+		taskSecrets = append(taskSecrets, args.Logging.FireLens.SecretOptions)
+	}
+	if args.RepositoryCredentials != nil {
+		taskSecrets = append(taskSecrets, pulumi.StringMap{"repositoryCredentials": args.RepositoryCredentials})
+	}
+	taskSecrets = append(taskSecrets, sidecarSecrets(args.Sidecars)...)
+
+	var taskSecretARNs pulumi.StringArrayInput
+	if len(taskSecrets) > 0 {
+		taskSecretARNs = secretARNs(taskSecrets...)
+	}
+
+	metabaseService, err := metabaseBuilder.NewMetabaseService(metabase.MetabaseServiceArgs{
+		ContainerDefinitions: metabaseContainerDef,
+		SubnetIDs:            ecsSubnetIDs,
+		SecurityGroupID:      metabaseSecurityGroup.ID(),
+		AssignPublicIP:       ecsAssignPublicIP,
+		Port:                 metabasePort,
+		TargetGroupARN:       targetGroup.Arn,
+		Listener:             lbListener,
+		HealthCheck:          healthCheck,
+		TaskRole:             metabaseTaskRole,
+		SecretARNs:           taskSecretARNs,
+		Architecture:         architecture,
+		Capacity:             capacity,
+		Schedule:             schedule,
+		Volumes:              volumes,
+		ClusterARN:           clusterARN,
+		Cluster:              clusterSettings,
+		ExecuteCommand:       executeCommand,
+		DependsOn:            serviceDependencies,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Only the digest of the official image tells whether the version's tag moved.
+	deployedImageDigest := imageDigest
+	if args.Image != nil {
+		deployedImageDigest = pulumi.String("").ToStringOutput()
+	}
+	_, err = metabaseBuilder.NewVersionParameter(imageTag, deployedImageDigest, metabaseService)
+	if err != nil {
+		return nil, errors.Wrap(err, "Recording the deployed Metabase version")
+	}
+
 	var metabaseDnsRecord *route53.Record
 	if attachDomainName {
 		metabaseDnsRecord, err = route53.NewRecord(ctx, "metabase-dns", &route53.RecordArgs{
@@ -242,6 +955,10 @@ func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...p
 	}
 
 	component.SecurityGroupID = metabaseSecurityGroup.ID().ToStringOutput()
+	component.LogGroupName = metabaseLogGroup.Name
+	component.TaskRoleARN = metabaseTaskRole.Arn
+	component.ImageRepository = imageRepository
+	component.ImageDigest = imageDigest
 
 	component.DNSName = loadBalancer.DnsName
 	if metabaseDnsRecord != nil {
@@ -251,6 +968,10 @@ func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...p
 	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
 		"securityGroupId": component.SecurityGroupID,
 		"dnsName":         component.DNSName,
+		"logGroupName":    component.LogGroupName,
+		"taskRoleArn":     component.TaskRoleARN,
+		"imageRepository": component.ImageRepository,
+		"imageDigest":     component.ImageDigest,
 	}); err != nil {
 		return nil, err
 	}
@@ -258,6 +979,57 @@ func NewMetabase(ctx *pulumi.Context, name string, args *MetabaseArgs, opts ...p
 	return component, nil
 }
 
+func resolveImageDigest(image string) (string, error) {
+	return metabase.ResolveImageDigest(context.Background(), image)
+}
+
+// lookupImageDigest resolves the digest of the image. Private ECR registries don't allow anonymous
+// pulls, so their images are looked up with the ECR API, which only reaches the registries of the
+// stack's region. An empty digest means the image can't be pinned.
+func lookupImageDigest(ctx *pulumi.Context, component pulumi.Resource, image string, region string) (string, error) {
+	ref := metabase.ParseImageReference(image)
+	registryID, imageRegion, isECR := ref.ECRRegistry()
+	if !isECR || ref.Digest != "" {
+		return resolveImageDigest(image)
+	}
+	if imageRegion != region {
+		_ = ctx.Log.Warn(fmt.Sprintf("%q is in the ECR registry of another region, its digest can't be looked up "+
+			"and the image isn't pinned", image), &pulumi.LogArgs{Resource: component})
+		return "", nil
+	}
+
+	result, err := ecr.GetImage(ctx, &ecr.GetImageArgs{
+		RegistryId:     &registryID,
+		RepositoryName: ref.Repository,
+		ImageTag:       &ref.Tag,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "looking up the digest of %q", image)
+	}
+	return result.ImageDigest, nil
+}
+
+// imageRepositoryName strips the tag or digest from an image reference.
+func imageRepositoryName(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	// A colon after the last slash separates the tag, any other colon is part of the registry's port.
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
+// defaultSiteURL returns the URL Metabase is reachable on, which is the custom domain when one is
+// configured and the load balancer otherwise.
+func defaultSiteURL(domain CustomDomain, attachDomainName bool, loadBalancer *lb.LoadBalancer) pulumi.StringOutput {
+	if attachDomainName {
+		return pulumi.Sprintf("https://%s", *domain.DomainName)
+	}
+	return pulumi.Sprintf("http://%s", loadBalancer.DnsName)
+}
+
 type metabaseEnvironmentVariable struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
@@ -267,21 +1039,162 @@ func newMetabaseEnvironmentVariable(name, value string) metabaseEnvironmentVaria
 	return metabaseEnvironmentVariable{Name: name, Value: value}
 }
 
-func newMetabaseContainer(cluster rds.Cluster, metabaseImageName, regionName pulumi.StringOutput) pulumi.StringOutput {
+// newHealthCheck fills in the defaults for any health check settings that weren't provided.
+func newHealthCheck(args HealthCheck) (metabase.HealthCheck, error) {
+	healthCheck := metabase.DefaultHealthCheck()
+	if args.Interval != nil {
+		healthCheck.Interval = *args.Interval
+	}
+	if args.Timeout != nil {
+		healthCheck.Timeout = *args.Timeout
+	}
+	if args.HealthyThreshold != nil {
+		healthCheck.HealthyThreshold = *args.HealthyThreshold
+	}
+	if args.UnhealthyThreshold != nil {
+		healthCheck.UnhealthyThreshold = *args.UnhealthyThreshold
+	}
+	if args.StartPeriod != nil {
+		healthCheck.StartPeriod = *args.StartPeriod
+	}
+
+	return healthCheck, healthCheck.Validate()
+}
+
+// mergeEnvironment overrides or appends the user provided variables to the component's defaults,
+// the additional variables are sorted by name so the container definition doesn't change between runs.
+func mergeEnvironment(env []metabaseEnvironmentVariable, overrides map[string]string) []metabaseEnvironmentVariable {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		overridden := false
+		for i := range env {
+			if env[i].Name == name {
+				env[i].Value = overrides[name]
+				overridden = true
+			}
+		}
+		if !overridden {
+			env = append(env, newMetabaseEnvironmentVariable(name, overrides[name]))
+		}
+	}
+	return env
+}
+
+type metabaseSecret struct {
+	Name      string `json:"name"`
+	ValueFrom string `json:"valueFrom"`
+}
+
+// newMetabaseSecrets converts a map of names to Secrets Manager or SSM Parameter Store ARNs into
+// the list ECS expects, sorted by name so the container definition doesn't change between runs.
+func newMetabaseSecrets(secrets map[string]string) []metabaseSecret {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]metabaseSecret, 0, len(secrets))
+	for _, name := range names {
+		result = append(result, metabaseSecret{Name: name, ValueFrom: secrets[name]})
+	}
+	return result
+}
+
+// secretARNs returns the sorted, de-duplicated ARNs of one or more maps of container secrets.
+func secretARNs(secrets ...pulumi.StringMapInput) pulumi.StringArrayOutput {
+	inputs := make([]interface{}, len(secrets))
+	for i, s := range secrets {
+		inputs[i] = s
+	}
+
+	return pulumi.All(inputs...).ApplyT(func(values []interface{}) []string {
+		seen := map[string]bool{}
+		var arns []string
+		for _, value := range values {
+			for _, arn := range value.(map[string]string) {
+				if !seen[arn] {
+					seen[arn] = true
+					arns = append(arns, arn)
+				}
+			}
+		}
+		sort.Strings(arns)
+		return arns
+	}).(pulumi.StringArrayOutput)
+}
+
+// The environment variables used to connect to the application database are managed by the
+// component, so they can't be set through `environment` or `secrets`.
+const managedEnvironmentPrefix = "MB_DB_"
+
+// The environment variable Metabase Enterprise reads its token from.
+const premiumEmbeddingTokenVariable = "MB_PREMIUM_EMBEDDING_TOKEN"
+
+// The environment variable pointing Metabase at the plugins volume.
+const pluginsDirVariable = "MB_PLUGINS_DIR"
+
+// validateManagedVariable checks a variable the component sets itself isn't also set by the user.
+func validateManagedVariable(name string, environment, secrets map[string]pulumi.StringInput) error {
+	_, inEnvironment := environment[name]
+	_, inSecrets := secrets[name]
+	if inEnvironment || inSecrets {
+		return fmt.Errorf("%q is managed by the component and can't be set in environment or secrets", name)
+	}
+	return nil
+}
+
+// knownStringMap returns the entries of a map input whose keys have to be known when planning, so the
+// component can check them. The values can still depend on other resources, such as the ARN of a
+// secret created in the same program.
+func knownStringMap(field string, input pulumi.StringMapInput) (map[string]pulumi.StringInput, error) {
+	switch m := input.(type) {
+	case nil:
+		return nil, nil
+	case pulumi.StringMap:
+		return m, nil
+	default:
+		return nil, fmt.Errorf("%s must be a map whose keys are known before deployment, only its values can depend on other resources", field)
+	}
+}
+
+// validateContainerEnvironment checks the user provided environment variables and secrets don't
+// clash with each other or with the variables managed by the component.
+func validateContainerEnvironment(environment, secrets map[string]pulumi.StringInput) error {
+	for name := range environment {
+		if strings.HasPrefix(name, managedEnvironmentPrefix) {
+			return fmt.Errorf("environment can't set %q, the %s* variables are managed by the component", name, managedEnvironmentPrefix)
+		}
+		if _, ok := secrets[name]; ok {
+			return fmt.Errorf("%q can't be set in both environment and secrets", name)
+		}
+	}
+	for name := range secrets {
+		if strings.HasPrefix(name, managedEnvironmentPrefix) {
+			return fmt.Errorf("secrets can't set %q, the %s* variables are managed by the component", name, managedEnvironmentPrefix)
+		}
+	}
+	return nil
+}
+
+// mysqlEnvironment returns the variables connecting Metabase to the MySQL cluster through the host,
+// either the cluster endpoint or the endpoint of its proxy.
+func mysqlEnvironment(cluster *rds.Cluster, host pulumi.StringOutput) pulumi.AnyOutput {
 	return pulumi.All(
-		cluster.Endpoint, cluster.MasterUsername, cluster.MasterPassword,
-		cluster.Port, cluster.DatabaseName, regionName, metabaseImageName,
-	).ApplyT(func(values []interface{}) (string, error) {
+		host, cluster.MasterUsername, cluster.MasterPassword, cluster.Port, cluster.DatabaseName,
+	).ApplyT(func(values []interface{}) interface{} {
 		hostname := values[0].(string)
 		username := values[1].(string)
 		password := values[2].(*string)
 		port := values[3].(int)
 		dbName := values[4].(string)
-		//region := values[5].(string)
-		imageName := values[6].(string)
 
-		metabaseEnv := []metabaseEnvironmentVariable{
-			newMetabaseEnvironmentVariable("JAVA_TIMEZONE", "US/Pacific"),
+		return []metabaseEnvironmentVariable{
 			newMetabaseEnvironmentVariable("MB_DB_TYPE", "mysql"),
 			newMetabaseEnvironmentVariable("MB_DB_DBNAME", dbName),
 			newMetabaseEnvironmentVariable("MB_DB_PORT", fmt.Sprintf("%d", port)),
@@ -289,27 +1202,173 @@ func newMetabaseContainer(cluster rds.Cluster, metabaseImageName, regionName pul
 			newMetabaseEnvironmentVariable("MB_DB_PASS", *password),
 			newMetabaseEnvironmentVariable("MB_DB_HOST", hostname),
 		}
+	}).(pulumi.AnyOutput)
+}
+
+// h2Environment returns the variables pointing Metabase at the H2 file on the data volume.
+func h2Environment() pulumi.AnyOutput {
+	return pulumi.Any([]metabaseEnvironmentVariable{
+		newMetabaseEnvironmentVariable("MB_DB_TYPE", "h2"),
+		newMetabaseEnvironmentVariable("MB_DB_FILE", metabase.H2DatabaseFile),
+	})
+}
+
+type metabaseContainerArgs struct {
+	// The MB_DB_* variables connecting Metabase to its application database.
+	databaseEnvironment pulumi.AnyOutput
+	imageName           pulumi.StringOutput
+	regionName          pulumi.StringOutput
+	logGroupName        pulumi.StringOutput
+	healthCheck         metabase.HealthCheck
+	fireLens            *FireLens
+	environment         map[string]pulumi.StringInput
+	secrets             map[string]pulumi.StringInput
+
+	// A custom image may not ship curl, so it's only health checked by the load balancer.
+	customImage bool
+	// The ARN of the Secrets Manager secret holding the credentials of a private registry.
+	repositoryCredentials pulumi.StringInput
+	// The number of seconds ECS waits for Metabase to exit before killing it, the ECS default when 0.
+	stopTimeout int
+	sidecars    []Sidecar
+	// Downloaded into the plugins volume by an init container before Metabase starts.
+	plugins []metabase.Plugin
+	// Whether the H2 data volume is mounted.
+	h2 bool
+	// Runs the Metabase and plugins-init containers as a non-root user with a read-only root
+	// filesystem. The plugins and temporary files volumes are mounted for the paths Metabase writes to.
+	hardened bool
+}
 
-		containerJSON, err := json.Marshal([]interface{}{
-			map[string]interface{}{
-				"name":  "metabase",
-				"image": imageName,
-				"portMappings": []map[string]interface{}{
-					{
-						"containerPort": metabasePort,
-					},
+func newMetabaseContainer(args metabaseContainerArgs) pulumi.StringOutput {
+	// FireLens settings are optional, an empty image means the log router isn't used.
+	fireLensImage := pulumi.String("").ToStringOutput()
+	fireLensOptions := pulumi.StringMap{}.ToStringMapOutput()
+	fireLensSecretOptions := pulumi.StringMap{}.ToStringMapOutput()
+	if args.fireLens != nil {
+		fireLensImage = pulumi.String(metabase.DefaultFireLensImage).ToStringOutput()
+		if args.fireLens.Image != nil {
+			fireLensImage = args.fireLens.Image.ToStringOutput()
+		}
+		if args.fireLens.Options != nil {
+			fireLensOptions = args.fireLens.Options.ToStringMapOutput()
+		}
+		if args.fireLens.SecretOptions != nil {
+			fireLensSecretOptions = args.fireLens.SecretOptions.ToStringMapOutput()
+		}
+	}
+
+	repositoryCredentials := pulumi.String("").ToStringOutput()
+	if args.repositoryCredentials != nil {
+		repositoryCredentials = args.repositoryCredentials.ToStringOutput()
+	}
+
+	return pulumi.All(
+		args.databaseEnvironment, args.regionName, args.imageName,
+		args.logGroupName, fireLensImage, fireLensOptions, fireLensSecretOptions,
+		pulumi.StringMap(args.environment), pulumi.StringMap(args.secrets),
+		repositoryCredentials, newSidecarContainers(args.sidecars, args.logGroupName, args.regionName),
+	).ApplyT(func(values []interface{}) (string, error) {
+		databaseEnv := values[0].([]metabaseEnvironmentVariable)
+		region := values[1].(string)
+		imageName := values[2].(string)
+		logGroup := values[3].(string)
+		fireLensImage := values[4].(string)
+		fireLensOptions := values[5].(map[string]string)
+		fireLensSecretOptions := values[6].(map[string]string)
+		environment := values[7].(map[string]string)
+		secrets := values[8].(map[string]string)
+		repositoryCredentials := values[9].(string)
+		sidecarContainers := values[10].([]interface{})
+
+		metabaseEnv := []metabaseEnvironmentVariable{
+			// Can be overridden with the `timezone` setting.
+			newMetabaseEnvironmentVariable("JAVA_TIMEZONE", "US/Pacific"),
+		}
+		metabaseEnv = append(metabaseEnv, databaseEnv...)
+		metabaseEnv = mergeEnvironment(metabaseEnv, environment)
+
+		metabaseContainer := map[string]interface{}{
+			"name":  "metabase",
+			"image": imageName,
+			"portMappings": []map[string]interface{}{
+				{
+					"containerPort": metabasePort,
 				},
-				"environment": metabaseEnv,
-				// "logConfiguration": map[string]interface{}{
-				// 	"logDriver": "awslogs",
-				// 	"options": map[string]interface{}{
-				// 		"awslogs-group":         "/ecs/metabase",
-				// 		"awslogs-region":        region,
-				// 		"awslogs-stream-prefix": "ecs",
-				// 	},
-				// },
 			},
-		})
+			"environment":      metabaseEnv,
+			"logConfiguration": metabase.AWSLogsConfiguration(logGroup, region, "metabase"),
+		}
+		if !args.customImage {
+			metabaseContainer["healthCheck"] = args.healthCheck.ContainerHealthCheck(metabasePort)
+		}
+		if len(secrets) > 0 {
+			metabaseContainer["secrets"] = newMetabaseSecrets(secrets)
+		}
+		if args.stopTimeout > 0 {
+			metabaseContainer["stopTimeout"] = args.stopTimeout
+		}
+		if repositoryCredentials != "" {
+			metabaseContainer["repositoryCredentials"] = map[string]interface{}{
+				"credentialsParameter": repositoryCredentials,
+			}
+		}
+		containers := []interface{}{metabaseContainer}
+		var dependsOn []map[string]interface{}
+
+		if fireLensImage != "" {
+			if _, ok := fireLensOptions["Name"]; !ok {
+				return "", fmt.Errorf("logging.firelens.options must set the Fluent Bit output plugin `Name`")
+			}
+
+			logConfiguration := metabase.FireLensLogConfiguration(fireLensOptions)
+			if len(fireLensSecretOptions) > 0 {
+				logConfiguration["secretOptions"] = newMetabaseSecrets(fireLensSecretOptions)
+			}
+			metabaseContainer["logConfiguration"] = logConfiguration
+			dependsOn = append(dependsOn, map[string]interface{}{
+				"containerName": metabase.FireLensContainerName,
+				"condition":     "START",
+			})
+			containers = append(containers, metabase.FireLensContainer(fireLensImage, logGroup, region))
+		}
+
+		var mountPoints []map[string]interface{}
+		if args.h2 {
+			mountPoints = append(mountPoints, map[string]interface{}{
+				"sourceVolume":  metabase.H2DataVolumeName,
+				"containerPath": metabase.H2DataDir,
+			})
+		}
+		// The init container also runs without plugins while the volume is kept, to remove the
+		// plugins installed by an earlier deployment.
+		if len(args.plugins) > 0 || args.hardened {
+			mountPoints = append(mountPoints, metabase.PluginsMountPoint())
+			dependsOn = append(dependsOn, map[string]interface{}{
+				"containerName": metabase.PluginsInitContainerName,
+				"condition":     "SUCCESS",
+			})
+			pluginsInit := metabase.PluginsInitContainer(metabase.DefaultPluginsInitImage, args.plugins, logGroup, region)
+			if args.hardened {
+				metabase.HardenContainer(pluginsInit)
+			}
+			containers = append(containers, pluginsInit)
+		}
+		if args.hardened {
+			mountPoints = append(mountPoints, metabase.TmpMountPoint())
+			metabase.HardenContainer(metabaseContainer)
+		}
+		if len(mountPoints) > 0 {
+			metabaseContainer["mountPoints"] = mountPoints
+		}
+
+		containers = append(containers, sidecarContainers...)
+		dependsOn = append(dependsOn, metabaseDependencies(args.sidecars)...)
+		if len(dependsOn) > 0 {
+			metabaseContainer["dependsOn"] = dependsOn
+		}
+
+		containerJSON, err := json.Marshal(containers)
 		if err != nil {
 			return "", err
 		}
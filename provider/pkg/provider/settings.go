@@ -0,0 +1,136 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	// The provider may run on hosts without a timezone database.
+	_ "time/tzdata"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Settings are the most commonly used Metabase application settings. Each setting maps to one of
+// the `MB_*` environment variables documented at
+// https://www.metabase.com/docs/latest/configuring-metabase/environment-variables.
+type Settings struct {
+	SiteName              *string `pulumi:"siteName"`
+	SiteURL               *string `pulumi:"siteUrl"`
+	Timezone              *string `pulumi:"timezone"`
+	SiteLocale            *string `pulumi:"siteLocale"`
+	AnonTrackingEnabled   *bool   `pulumi:"anonTrackingEnabled"`
+	PasswordComplexity    *string `pulumi:"passwordComplexity"`
+	SessionTimeoutMinutes *int    `pulumi:"sessionTimeoutMinutes"`
+	EnableQueryCaching    *bool   `pulumi:"enableQueryCaching"`
+	AdminEmail            *string `pulumi:"adminEmail"`
+}
+
+var siteLocalePattern = regexp.MustCompile(`^[a-z]{2,3}(_[A-Z]{2})?$`)
+
+func (s Settings) Validate() error {
+	if s.SiteURL != nil {
+		u, err := url.Parse(*s.SiteURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("settings.siteUrl must be an absolute http or https URL, got %q", *s.SiteURL)
+		}
+	}
+	if s.Timezone != nil {
+		if _, err := time.LoadLocation(*s.Timezone); err != nil {
+			return fmt.Errorf("settings.timezone must be an IANA timezone such as \"Europe/London\", got %q", *s.Timezone)
+		}
+	}
+	if s.SiteLocale != nil && !siteLocalePattern.MatchString(*s.SiteLocale) {
+		return fmt.Errorf("settings.siteLocale must be a locale such as \"en\" or \"pt_BR\", got %q", *s.SiteLocale)
+	}
+	if s.PasswordComplexity != nil {
+		switch *s.PasswordComplexity {
+		case "weak", "normal", "strong":
+		default:
+			return fmt.Errorf("settings.passwordComplexity must be one of weak, normal or strong, got %q", *s.PasswordComplexity)
+		}
+	}
+	if s.SessionTimeoutMinutes != nil && *s.SessionTimeoutMinutes <= 0 {
+		return fmt.Errorf("settings.sessionTimeoutMinutes must be greater than 0, got %d", *s.SessionTimeoutMinutes)
+	}
+	if s.AdminEmail != nil {
+		if _, err := mail.ParseAddress(*s.AdminEmail); err != nil {
+			return fmt.Errorf("settings.adminEmail must be an email address, got %q", *s.AdminEmail)
+		}
+	}
+	return nil
+}
+
+// Environment returns the `MB_*` environment variables for the settings that have been set.
+func (s Settings) Environment() map[string]string {
+	env := map[string]string{}
+	if s.SiteName != nil {
+		env["MB_SITE_NAME"] = *s.SiteName
+	}
+	if s.SiteURL != nil {
+		env["MB_SITE_URL"] = *s.SiteURL
+	}
+	if s.Timezone != nil {
+		env["JAVA_TIMEZONE"] = *s.Timezone
+	}
+	if s.SiteLocale != nil {
+		env["MB_SITE_LOCALE"] = *s.SiteLocale
+	}
+	if s.AnonTrackingEnabled != nil {
+		env["MB_ANON_TRACKING_ENABLED"] = strconv.FormatBool(*s.AnonTrackingEnabled)
+	}
+	if s.PasswordComplexity != nil {
+		env["MB_PASSWORD_COMPLEXITY"] = *s.PasswordComplexity
+	}
+	if s.SessionTimeoutMinutes != nil {
+		timeout, _ := json.Marshal(map[string]interface{}{
+			"amount": *s.SessionTimeoutMinutes,
+			"unit":   "minutes",
+		})
+		env["MB_SESSION_TIMEOUT"] = string(timeout)
+	}
+	if s.EnableQueryCaching != nil {
+		env["MB_ENABLE_QUERY_CACHING"] = strconv.FormatBool(*s.EnableQueryCaching)
+	}
+	if s.AdminEmail != nil {
+		env["MB_ADMIN_EMAIL"] = *s.AdminEmail
+	}
+	return env
+}
+
+// withSettings adds the environment variables of the typed settings to the user provided ones.
+// A variable can't be set both through a setting and through `environment` or `secrets`.
+func withSettings(settings Settings, environment, secrets map[string]pulumi.StringInput) (map[string]pulumi.StringInput, error) {
+	result := make(map[string]pulumi.StringInput, len(environment))
+	for name, value := range environment {
+		result[name] = value
+	}
+
+	for name, value := range settings.Environment() {
+		_, inEnvironment := environment[name]
+		_, inSecrets := secrets[name]
+		if inEnvironment || inSecrets {
+			return nil, fmt.Errorf("%q is set by settings and can't also be set in environment or secrets", name)
+		}
+		result[name] = pulumi.String(value)
+	}
+	return result, nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-metabase/pkg/metabase"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Sidecar is an extra container run in the Metabase task, such as a monitoring agent.
+type Sidecar struct {
+	Name         string                `pulumi:"name"`
+	Image        pulumi.StringInput    `pulumi:"image"`
+	Essential    *bool                 `pulumi:"essential"`
+	Environment  pulumi.StringMapInput `pulumi:"environment"`
+	Secrets      pulumi.StringMapInput `pulumi:"secrets"`
+	PortMappings []SidecarPortMapping  `pulumi:"portMappings"`
+	DependsOn    []ContainerDependency `pulumi:"dependsOn"`
+	HealthCheck  *SidecarHealthCheck   `pulumi:"healthCheck"`
+
+	// The condition the sidecar has to reach before Metabase is started.
+	MetabaseDependsOn *string `pulumi:"metabaseDependsOn"`
+}
+
+type SidecarPortMapping struct {
+	ContainerPort int     `pulumi:"containerPort"`
+	Protocol      *string `pulumi:"protocol"`
+}
+
+type ContainerDependency struct {
+	ContainerName string `pulumi:"containerName"`
+	Condition     string `pulumi:"condition"`
+}
+
+type SidecarHealthCheck struct {
+	Command     []string `pulumi:"command"`
+	Interval    *int     `pulumi:"interval"`
+	Timeout     *int     `pulumi:"timeout"`
+	Retries     *int     `pulumi:"retries"`
+	StartPeriod *int     `pulumi:"startPeriod"`
+}
+
+func validateContainerCondition(field, condition string) error {
+	switch condition {
+	case "START", "COMPLETE", "SUCCESS", "HEALTHY":
+		return nil
+	default:
+		return fmt.Errorf("%s must be one of START, COMPLETE, SUCCESS or HEALTHY, got %q", field, condition)
+	}
+}
+
+// validateSidecars checks the sidecars can run next to Metabase in the same task.
+func validateSidecars(sidecars []Sidecar) error {
+	names := map[string]Sidecar{}
+	for _, sidecar := range sidecars {
+		switch {
+		case sidecar.Name == "":
+			return fmt.Errorf("sidecars must have a name")
+		case sidecar.Name == "metabase" || sidecar.Name == metabase.FireLensContainerName ||
+			sidecar.Name == metabase.PluginsInitContainerName:
+			return fmt.Errorf("sidecars can't use the container name %q, it's reserved by the component", sidecar.Name)
+		}
+		if _, ok := names[sidecar.Name]; ok {
+			return fmt.Errorf("sidecars contains more than one container named %q", sidecar.Name)
+		}
+		if sidecar.Image == nil {
+			return fmt.Errorf("sidecar %q must have an image", sidecar.Name)
+		}
+		if sidecar.HealthCheck != nil && len(sidecar.HealthCheck.Command) == 0 {
+			return fmt.Errorf("sidecar %q healthCheck.command is required", sidecar.Name)
+		}
+		for _, mapping := range sidecar.PortMappings {
+			// Containers in an awsvpc task share the network namespace.
+			if mapping.ContainerPort == metabasePort {
+				return fmt.Errorf("sidecar %q can't map port %d, it's used by Metabase", sidecar.Name, metabasePort)
+			}
+		}
+		names[sidecar.Name] = sidecar
+	}
+
+	for _, sidecar := range sidecars {
+		for _, dependency := range sidecar.DependsOn {
+			field := fmt.Sprintf("sidecar %q dependsOn condition", sidecar.Name)
+			if err := validateContainerCondition(field, dependency.Condition); err != nil {
+				return err
+			}
+			dependsOn, ok := names[dependency.ContainerName]
+			if !ok || dependency.ContainerName == sidecar.Name {
+				return fmt.Errorf("sidecar %q can only depend on the other sidecars, got %q", sidecar.Name, dependency.ContainerName)
+			}
+			if dependency.Condition == "HEALTHY" && dependsOn.HealthCheck == nil {
+				return fmt.Errorf("sidecar %q can't wait for %q to be HEALTHY, it has no healthCheck", sidecar.Name, dependency.ContainerName)
+			}
+		}
+		if sidecar.MetabaseDependsOn != nil {
+			field := fmt.Sprintf("sidecar %q metabaseDependsOn", sidecar.Name)
+			if err := validateContainerCondition(field, *sidecar.MetabaseDependsOn); err != nil {
+				return err
+			}
+			if *sidecar.MetabaseDependsOn == "HEALTHY" && sidecar.HealthCheck == nil {
+				return fmt.Errorf("Metabase can't wait for sidecar %q to be HEALTHY, it has no healthCheck", sidecar.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// sidecarSecrets returns the secrets of every sidecar, so the execution role can be allowed to read them.
+func sidecarSecrets(sidecars []Sidecar) []pulumi.StringMapInput {
+	var secrets []pulumi.StringMapInput
+	for _, sidecar := range sidecars {
+		if sidecar.Secrets != nil {
+			secrets = append(secrets, sidecar.Secrets)
+		}
+	}
+	return secrets
+}
+
+// metabaseDependencies returns the `dependsOn` entries of the Metabase container for the sidecars it waits for.
+func metabaseDependencies(sidecars []Sidecar) []map[string]interface{} {
+	var dependencies []map[string]interface{}
+	for _, sidecar := range sidecars {
+		if sidecar.MetabaseDependsOn != nil {
+			dependencies = append(dependencies, map[string]interface{}{
+				"containerName": sidecar.Name,
+				"condition":     *sidecar.MetabaseDependsOn,
+			})
+		}
+	}
+	return dependencies
+}
+
+// newSidecarContainers returns the container definitions of the sidecars. Their logs are sent to the
+// Metabase log group under a stream prefix named after the sidecar.
+func newSidecarContainers(sidecars []Sidecar, logGroupName, regionName pulumi.StringOutput) pulumi.ArrayOutput {
+	containers := make([]interface{}, 0, len(sidecars))
+	for _, sidecar := range sidecars {
+		sidecar := sidecar
+		// The sidecar's variables aren't checked, so they can be outputs of other resources as a whole.
+		environment, secrets := pulumi.StringMapInput(pulumi.StringMap{}), pulumi.StringMapInput(pulumi.StringMap{})
+		if sidecar.Environment != nil {
+			environment = sidecar.Environment
+		}
+		if sidecar.Secrets != nil {
+			secrets = sidecar.Secrets
+		}
+		container := pulumi.All(
+			sidecar.Image, environment, secrets, logGroupName, regionName,
+		).ApplyT(func(values []interface{}) map[string]interface{} {
+			image := values[0].(string)
+			environment := values[1].(map[string]string)
+			secrets := values[2].(map[string]string)
+			logGroup := values[3].(string)
+			region := values[4].(string)
+
+			container := map[string]interface{}{
+				"name":             sidecar.Name,
+				"image":            image,
+				"essential":        sidecar.Essential == nil || *sidecar.Essential,
+				"logConfiguration": metabase.AWSLogsConfiguration(logGroup, region, sidecar.Name),
+			}
+			if len(environment) > 0 {
+				container["environment"] = mergeEnvironment(nil, environment)
+			}
+			if len(secrets) > 0 {
+				container["secrets"] = newMetabaseSecrets(secrets)
+			}
+			if len(sidecar.PortMappings) > 0 {
+				portMappings := make([]map[string]interface{}, 0, len(sidecar.PortMappings))
+				for _, mapping := range sidecar.PortMappings {
+					portMapping := map[string]interface{}{"containerPort": mapping.ContainerPort}
+					if mapping.Protocol != nil {
+						portMapping["protocol"] = *mapping.Protocol
+					}
+					portMappings = append(portMappings, portMapping)
+				}
+				container["portMappings"] = portMappings
+			}
+			if len(sidecar.DependsOn) > 0 {
+				dependsOn := make([]map[string]interface{}, 0, len(sidecar.DependsOn))
+				for _, dependency := range sidecar.DependsOn {
+					dependsOn = append(dependsOn, map[string]interface{}{
+						"containerName": dependency.ContainerName,
+						"condition":     dependency.Condition,
+					})
+				}
+				container["dependsOn"] = dependsOn
+			}
+			if healthCheck := sidecar.HealthCheck; healthCheck != nil {
+				check := map[string]interface{}{"command": healthCheck.Command}
+				if healthCheck.Interval != nil {
+					check["interval"] = *healthCheck.Interval
+				}
+				if healthCheck.Timeout != nil {
+					check["timeout"] = *healthCheck.Timeout
+				}
+				if healthCheck.Retries != nil {
+					check["retries"] = *healthCheck.Retries
+				}
+				if healthCheck.StartPeriod != nil {
+					check["startPeriod"] = *healthCheck.StartPeriod
+				}
+				container["healthCheck"] = check
+			}
+			return container
+		})
+		containers = append(containers, container)
+	}
+	return pulumi.All(containers...)
+}
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// An item of a cluster's default capacity provider strategy.
+    /// </summary>
+    public sealed class CapacityProviderStrategyArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The number of tasks to run on the capacity provider before the weights apply. Only one capacity
+        /// provider can have a base. Defaults to `0`.
+        /// </summary>
+        [Input("base")]
+        public int? Base { get; set; }
+
+        /// <summary>
+        /// The capacity provider, either `FARGATE` or `FARGATE_SPOT`.
+        /// </summary>
+        [Input("capacityProvider", required: true)]
+        public string CapacityProvider { get; set; } = null!;
+
+        /// <summary>
+        /// The relative share of tasks launched on the capacity provider. Defaults to `0`.
+        /// </summary>
+        [Input("weight")]
+        public int? Weight { get; set; }
+
+        public CapacityProviderStrategyArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Settings of the ECS cluster created for Metabase.
+    /// </summary>
+    public sealed class ClusterArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+        /// storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+        /// </summary>
+        [Input("containerInsights")]
+        public bool? ContainerInsights { get; set; }
+
+        [Input("defaultCapacityProviderStrategy")]
+        private List<Input<Inputs.CapacityProviderStrategyArgs>>? _defaultCapacityProviderStrategy;
+
+        /// <summary>
+        /// The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+        /// of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+        /// type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+        /// associated with the cluster when this is set.
+        /// </summary>
+        public List<Input<Inputs.CapacityProviderStrategyArgs>> DefaultCapacityProviderStrategy
+        {
+            get => _defaultCapacityProviderStrategy ?? (_defaultCapacityProviderStrategy = new List<Input<Inputs.CapacityProviderStrategyArgs>>());
+            set => _defaultCapacityProviderStrategy = value;
+        }
+
+        [Input("tags")]
+        private InputMap<string>? _tags;
+
+        /// <summary>
+        /// Tags to apply to the cluster.
+        /// </summary>
+        public InputMap<string> Tags
+        {
+            get => _tags ?? (_tags = new InputMap<string>());
+            set => _tags = value;
+        }
+
+        public ClusterArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Options for the Fargate compute the Metabase task runs on.
+    /// </summary>
+    public sealed class ComputeArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+        /// published for the architecture, which is checked against the registry unless the image needs
+        /// `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+        /// </summary>
+        [Input("architecture")]
+        public string? Architecture { get; set; }
+
+        /// <summary>
+        /// Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+        /// task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+        /// on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+        /// non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+        /// doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+        /// `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+        /// </summary>
+        [Input("capacity")]
+        public string? Capacity { get; set; }
+
+        public ComputeArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// A container a sidecar waits for before it's started.
+    /// </summary>
+    public sealed class ContainerDependencyArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The condition to wait for, one of `START`, `COMPLETE`, `SUCCESS` or `HEALTHY`.
+        /// </summary>
+        [Input("condition", required: true)]
+        public string Condition { get; set; } = null!;
+
+        /// <summary>
+        /// The name of the sidecar to wait for.
+        /// </summary>
+        [Input("containerName", required: true)]
+        public string ContainerName { get; set; } = null!;
+
+        public ContainerDependencyArgs()
+        {
+        }
+    }
+}
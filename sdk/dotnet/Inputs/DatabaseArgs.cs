@@ -15,19 +15,42 @@ namespace Pulumi.Metabase.Inputs
     /// </summary>
     public sealed class DatabaseArgs : Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+        /// file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+        /// but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
+        /// </summary>
+        [Input("engine")]
+        public string? Engine { get; set; }
+
         /// <summary>
         /// The database engine version. Updating this argument results in an outage. See the
         /// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
         /// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
         /// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-        /// where supported by the API.
+        /// where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+        /// which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+        /// versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+        /// later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+        /// Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+        /// Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+        /// `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
         /// </summary>
         [Input("engineVersion")]
         public Input<string>? EngineVersion { get; set; }
 
+        /// <summary>
+        /// Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+        /// scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+        /// Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+        /// Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+        /// Defaults to `false`.
+        /// </summary>
+        [Input("useProxy")]
+        public bool? UseProxy { get; set; }
+
         public DatabaseArgs()
         {
-            EngineVersion = "5.7.mysql_aurora.2.08.3";
         }
     }
 }
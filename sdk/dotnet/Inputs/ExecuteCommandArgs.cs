@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Options for ECS Exec sessions into the Metabase container.
+    /// </summary>
+    public sealed class ExecuteCommandArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+        /// is allowed to decrypt with the key.
+        /// </summary>
+        [Input("kmsKeyId")]
+        public Input<string>? KmsKeyId { get; set; }
+
+        /// <summary>
+        /// The name of a CloudWatch log group to log the session output to.
+        /// </summary>
+        [Input("logGroupName")]
+        public Input<string>? LogGroupName { get; set; }
+
+        /// <summary>
+        /// The name of an S3 bucket to log the session output to.
+        /// </summary>
+        [Input("s3BucketName")]
+        public Input<string>? S3BucketName { get; set; }
+
+        /// <summary>
+        /// The key prefix of the session logs in `s3BucketName`.
+        /// </summary>
+        [Input("s3KeyPrefix")]
+        public Input<string>? S3KeyPrefix { get; set; }
+
+        public ExecuteCommandArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,55 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Options for routing the Metabase container logs with AWS FireLens.
+    /// </summary>
+    public sealed class FireLensArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The Fluent Bit image to run as the log router.
+        /// </summary>
+        [Input("image")]
+        public Input<string>? Image { get; set; }
+
+        [Input("options")]
+        private InputMap<string>? _options;
+
+        /// <summary>
+        /// The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+        /// `Name` of the output plugin is required.
+        /// </summary>
+        public InputMap<string> Options
+        {
+            get => _options ?? (_options = new InputMap<string>());
+            set => _options = value;
+        }
+
+        [Input("secretOptions")]
+        private InputMap<string>? _secretOptions;
+
+        /// <summary>
+        /// Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+        /// of setting name to secret ARN. The task execution role is granted access to these secrets.
+        /// </summary>
+        public InputMap<string> SecretOptions
+        {
+            get => _secretOptions ?? (_secretOptions = new InputMap<string>());
+            set => _secretOptions = value;
+        }
+
+        public FireLensArgs()
+        {
+            Image = "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable";
+        }
+    }
+}
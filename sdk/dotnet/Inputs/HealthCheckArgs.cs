@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Options for the health checks run by the load balancer target group and the ECS container
+    /// against Metabase's `/api/health` endpoint. The container isn't health checked when running a custom `image`.
+    /// </summary>
+    public sealed class HealthCheckArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The number of consecutive successful health checks required before a task is considered healthy.
+        /// </summary>
+        [Input("healthyThreshold")]
+        public int? HealthyThreshold { get; set; }
+
+        /// <summary>
+        /// The approximate number of seconds between health checks. Must be between 5 and 300.
+        /// </summary>
+        [Input("interval")]
+        public int? Interval { get; set; }
+
+        /// <summary>
+        /// The number of seconds to give Metabase to start and run its database migrations before failed
+        /// health checks count against it. Must be between 0 and 300.
+        /// </summary>
+        [Input("startPeriod")]
+        public int? StartPeriod { get; set; }
+
+        /// <summary>
+        /// The number of seconds to wait for a response before failing a health check. Must be between
+        /// 2 and 60 and less than `interval`.
+        /// </summary>
+        [Input("timeout")]
+        public int? Timeout { get; set; }
+
+        /// <summary>
+        /// The number of consecutive failed health checks required before a task is considered unhealthy.
+        /// </summary>
+        [Input("unhealthyThreshold")]
+        public int? UnhealthyThreshold { get; set; }
+
+        public HealthCheckArgs()
+        {
+            HealthyThreshold = 2;
+            Interval = 30;
+            StartPeriod = 300;
+            Timeout = 5;
+            UnhealthyThreshold = 5;
+        }
+    }
+}
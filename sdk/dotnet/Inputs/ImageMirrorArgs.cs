@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Options for serving the Metabase image from a private ECR registry instead of Docker Hub.
+    /// </summary>
+    public sealed class ImageMirrorArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+        /// </summary>
+        [Input("scanOnPush")]
+        public bool? ScanOnPush { get; set; }
+
+        /// <summary>
+        /// How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+        /// with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+        /// it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+        /// stack, which deletes its images when the mirror is removed.
+        /// </summary>
+        [Input("type")]
+        public string? Type { get; set; }
+
+        public ImageMirrorArgs()
+        {
+            ScanOnPush = true;
+        }
+    }
+}
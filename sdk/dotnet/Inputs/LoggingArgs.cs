@@ -0,0 +1,44 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Options for shipping the Metabase container logs to CloudWatch Logs.
+    /// </summary>
+    public sealed class LoggingArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+        /// instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+        /// </summary>
+        [Input("firelens")]
+        public Input<Inputs.FireLensArgs>? Firelens { get; set; }
+
+        /// <summary>
+        /// The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+        /// service principal to use the key.
+        /// </summary>
+        [Input("kmsKeyId")]
+        public Input<string>? KmsKeyId { get; set; }
+
+        /// <summary>
+        /// The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+        /// 150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+        /// </summary>
+        [Input("retentionInDays")]
+        public int? RetentionInDays { get; set; }
+
+        public LoggingArgs()
+        {
+            RetentionInDays = 30;
+        }
+    }
+}
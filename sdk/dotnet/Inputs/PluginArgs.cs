@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// A Metabase plugin JAR, such as a community database driver.
+    /// </summary>
+    public sealed class PluginArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The hex encoded SHA-256 checksum of the JAR. The plugin isn't installed if it doesn't match.
+        /// </summary>
+        [Input("sha256", required: true)]
+        public string Sha256 { get; set; } = null!;
+
+        /// <summary>
+        /// The https URL to download the JAR from.
+        /// </summary>
+        [Input("url", required: true)]
+        public string Url { get; set; } = null!;
+
+        public PluginArgs()
+        {
+        }
+    }
+}
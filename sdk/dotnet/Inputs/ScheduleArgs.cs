@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// A schedule to stop Metabase outside working hours by scaling the service to zero tasks and back to one.
+    /// The Aurora Serverless application database pauses once Metabase has been stopped for five minutes.
+    /// </summary>
+    public sealed class ScheduleArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+        /// `0 19 ? * MON-FRI *` for 7pm on weekdays.
+        /// </summary>
+        [Input("scaleDown", required: true)]
+        public string ScaleDown { get; set; } = null!;
+
+        /// <summary>
+        /// When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+        /// </summary>
+        [Input("scaleUp", required: true)]
+        public string ScaleUp { get; set; } = null!;
+
+        /// <summary>
+        /// The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+        /// </summary>
+        [Input("timezone")]
+        public string? Timezone { get; set; }
+
+        public ScheduleArgs()
+        {
+        }
+    }
+}
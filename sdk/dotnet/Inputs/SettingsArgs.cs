@@ -0,0 +1,79 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Commonly used Metabase application settings. Each setting is passed to Metabase through its `MB_*`
+    /// [environment variable](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables)
+    /// and can't also be set through `environment` or `secrets`.
+    /// </summary>
+    public sealed class SettingsArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+        /// </summary>
+        [Input("adminEmail")]
+        public string? AdminEmail { get; set; }
+
+        /// <summary>
+        /// Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+        /// </summary>
+        [Input("anonTrackingEnabled")]
+        public bool? AnonTrackingEnabled { get; set; }
+
+        /// <summary>
+        /// Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+        /// </summary>
+        [Input("enableQueryCaching")]
+        public bool? EnableQueryCaching { get; set; }
+
+        /// <summary>
+        /// The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+        /// </summary>
+        [Input("passwordComplexity")]
+        public string? PasswordComplexity { get; set; }
+
+        /// <summary>
+        /// The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+        /// </summary>
+        [Input("sessionTimeoutMinutes")]
+        public int? SessionTimeoutMinutes { get; set; }
+
+        /// <summary>
+        /// The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+        /// </summary>
+        [Input("siteLocale")]
+        public string? SiteLocale { get; set; }
+
+        /// <summary>
+        /// The name used for this Metabase instance (`MB_SITE_NAME`).
+        /// </summary>
+        [Input("siteName")]
+        public string? SiteName { get; set; }
+
+        /// <summary>
+        /// The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+        /// custom domain when one is configured, and to the load balancer's DNS name otherwise.
+        /// </summary>
+        [Input("siteUrl")]
+        public string? SiteUrl { get; set; }
+
+        /// <summary>
+        /// The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+        /// </summary>
+        [Input("timezone")]
+        public string? Timezone { get; set; }
+
+        public SettingsArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// An extra container run in the Metabase task, such as a monitoring agent or a telemetry collector.
+    /// </summary>
+    public sealed class SidecarArgs : Pulumi.ResourceArgs
+    {
+        [Input("dependsOn")]
+        private List<Input<Inputs.ContainerDependencyArgs>>? _dependsOn;
+
+        /// <summary>
+        /// The other sidecars this container waits for before it's started.
+        /// </summary>
+        public List<Input<Inputs.ContainerDependencyArgs>> DependsOn
+        {
+            get => _dependsOn ?? (_dependsOn = new List<Input<Inputs.ContainerDependencyArgs>>());
+            set => _dependsOn = value;
+        }
+
+        [Input("environment")]
+        private InputMap<string>? _environment;
+
+        /// <summary>
+        /// Environment variables for the container.
+        /// </summary>
+        public InputMap<string> Environment
+        {
+            get => _environment ?? (_environment = new InputMap<string>());
+            set => _environment = value;
+        }
+
+        /// <summary>
+        /// Whether the task is stopped when the container exits. Defaults to `true`.
+        /// </summary>
+        [Input("essential")]
+        public bool? Essential { get; set; }
+
+        /// <summary>
+        /// A Docker health check for the container, required to depend on it being `HEALTHY`.
+        /// </summary>
+        [Input("healthCheck")]
+        public Input<Inputs.SidecarHealthCheckArgs>? HealthCheck { get; set; }
+
+        /// <summary>
+        /// The image to run.
+        /// </summary>
+        [Input("image", required: true)]
+        public Input<string> Image { get; set; } = null!;
+
+        /// <summary>
+        /// The condition the container has to reach before Metabase is started, one of `START`, `COMPLETE`,
+        /// `SUCCESS` or `HEALTHY`. Metabase doesn't wait for the container when not set.
+        /// </summary>
+        [Input("metabaseDependsOn")]
+        public string? MetabaseDependsOn { get; set; }
+
+        /// <summary>
+        /// The name of the container. `metabase`, `log-router` and `plugins-init` are reserved by the component.
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        [Input("portMappings")]
+        private List<Input<Inputs.SidecarPortMappingArgs>>? _portMappings;
+
+        /// <summary>
+        /// The ports the container listens on. Port 3000 is used by Metabase.
+        /// </summary>
+        public List<Input<Inputs.SidecarPortMappingArgs>> PortMappings
+        {
+            get => _portMappings ?? (_portMappings = new List<Input<Inputs.SidecarPortMappingArgs>>());
+            set => _portMappings = value;
+        }
+
+        [Input("secrets")]
+        private InputMap<string>? _secrets;
+
+        /// <summary>
+        /// Environment variables whose values are read from Secrets Manager or SSM Parameter Store, as a map of
+        /// variable name to secret ARN. The task execution role is granted access to these secrets.
+        /// </summary>
+        public InputMap<string> Secrets
+        {
+            get => _secrets ?? (_secrets = new InputMap<string>());
+            set => _secrets = value;
+        }
+
+        public SidecarArgs()
+        {
+        }
+    }
+}
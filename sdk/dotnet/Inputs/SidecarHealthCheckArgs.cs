@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// A Docker health check for a sidecar container.
+    /// </summary>
+    public sealed class SidecarHealthCheckArgs : Pulumi.ResourceArgs
+    {
+        [Input("command", required: true)]
+        private List<Input<string>>? _command;
+
+        /// <summary>
+        /// The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+        /// </summary>
+        public List<Input<string>> Command
+        {
+            get => _command ?? (_command = new List<Input<string>>());
+            set => _command = value;
+        }
+
+        /// <summary>
+        /// The number of seconds between health checks.
+        /// </summary>
+        [Input("interval")]
+        public int? Interval { get; set; }
+
+        /// <summary>
+        /// The number of consecutive failures before the container is unhealthy.
+        /// </summary>
+        [Input("retries")]
+        public int? Retries { get; set; }
+
+        /// <summary>
+        /// The number of seconds to give the container to start before failures count.
+        /// </summary>
+        [Input("startPeriod")]
+        public int? StartPeriod { get; set; }
+
+        /// <summary>
+        /// The number of seconds to wait for the command to succeed.
+        /// </summary>
+        [Input("timeout")]
+        public int? Timeout { get; set; }
+
+        public SidecarHealthCheckArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// A port a sidecar container listens on.
+    /// </summary>
+    public sealed class SidecarPortMappingArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The port number.
+        /// </summary>
+        [Input("containerPort", required: true)]
+        public int ContainerPort { get; set; }
+
+        /// <summary>
+        /// Either `tcp` or `udp`. Defaults to `tcp`.
+        /// </summary>
+        [Input("protocol")]
+        public string? Protocol { get; set; }
+
+        public SidecarPortMappingArgs()
+        {
+        }
+    }
+}
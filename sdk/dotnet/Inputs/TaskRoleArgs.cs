@@ -0,0 +1,68 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Metabase.Inputs
+{
+
+    /// <summary>
+    /// Options for the IAM role assumed by the Metabase container. Use it to let Metabase connect to data
+    /// sources such as Athena, S3 or Redshift with IAM credentials.
+    /// </summary>
+    public sealed class TaskRoleArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+        /// </summary>
+        [Input("athenaResultsBucket")]
+        public Input<string>? AthenaResultsBucket { get; set; }
+
+        [Input("inlinePolicies")]
+        private InputMap<string>? _inlinePolicies;
+
+        /// <summary>
+        /// Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+        /// </summary>
+        public InputMap<string> InlinePolicies
+        {
+            get => _inlinePolicies ?? (_inlinePolicies = new InputMap<string>());
+            set => _inlinePolicies = value;
+        }
+
+        [Input("managedPolicyArns")]
+        private InputList<string>? _managedPolicyArns;
+
+        /// <summary>
+        /// The ARNs of IAM managed policies to attach to the task role.
+        /// </summary>
+        public InputList<string> ManagedPolicyArns
+        {
+            get => _managedPolicyArns ?? (_managedPolicyArns = new InputList<string>());
+            set => _managedPolicyArns = value;
+        }
+
+        [Input("presets")]
+        private List<Input<string>>? _presets;
+
+        /// <summary>
+        /// Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+        /// which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+        /// Access to the S3 buckets backing Athena tables must be granted separately, for example with
+        /// the `s3-read-only` preset.
+        /// </summary>
+        public List<Input<string>> Presets
+        {
+            get => _presets ?? (_presets = new List<Input<string>>());
+            set => _presets = value;
+        }
+
+        public TaskRoleArgs()
+        {
+        }
+    }
+}
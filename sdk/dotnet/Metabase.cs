@@ -94,12 +94,36 @@ namespace Pulumi.Metabase
         [Output("dnsName")]
         public Output<string> DnsName { get; private set; } = null!;
 
+        /// <summary>
+        /// The digest the Metabase image is pinned to, empty when the image isn't pinned.
+        /// </summary>
+        [Output("imageDigest")]
+        public Output<string> ImageDigest { get; private set; } = null!;
+
+        /// <summary>
+        /// The repository the Metabase image is pulled from.
+        /// </summary>
+        [Output("imageRepository")]
+        public Output<string> ImageRepository { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the CloudWatch log group the Metabase container logs are sent to.
+        /// </summary>
+        [Output("logGroupName")]
+        public Output<string> LogGroupName { get; private set; } = null!;
+
         /// <summary>
         /// The security group id for the Metabase instance.
         /// </summary>
         [Output("securityGroupId")]
         public Output<string> SecurityGroupId { get; private set; } = null!;
 
+        /// <summary>
+        /// The ARN of the IAM role assumed by the Metabase container.
+        /// </summary>
+        [Output("taskRoleArn")]
+        public Output<string> TaskRoleArn { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Metabase resource with the given unique name, arguments, and options.
@@ -128,6 +152,29 @@ namespace Pulumi.Metabase
 
     public sealed class MetabaseArgs : Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Metabase's database migrations only run forwards, so deploying an older `metabaseVersion` than the one
+        /// last deployed is refused. The deployed version is recorded in the SSM parameter
+        /// `/pulumi/metabase/&lt;project&gt;/&lt;stack&gt;/&lt;name&gt;/version`. A deployed tag that doesn't name a release, such as
+        /// `latest`, can't be compared, so moving from it to a version only logs a warning. Set this to deploy an older
+        /// version anyway, typically after restoring a database snapshot taken before the upgrade.
+        /// </summary>
+        [Input("allowDowngrade")]
+        public bool? AllowDowngrade { get; set; }
+
+        /// <summary>
+        /// Optionally configure the ECS cluster created for Metabase, such as enabling Container Insights. Can't be
+        /// used with `ecsClusterArn`.
+        /// </summary>
+        [Input("cluster")]
+        public Input<Inputs.ClusterArgs>? Cluster { get; set; }
+
+        /// <summary>
+        /// Optionally configure the Fargate compute Metabase runs on.
+        /// </summary>
+        [Input("compute")]
+        public Input<Inputs.ComputeArgs>? Compute { get; set; }
+
         /// <summary>
         /// Optional arguments for configuring your RDS instance.
         /// </summary>
@@ -141,7 +188,96 @@ namespace Pulumi.Metabase
         public Input<Inputs.CustomDomainArgs>? Domain { get; set; }
 
         /// <summary>
-        /// The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image.
+        /// The ARN of an existing ECS cluster to run the Metabase service on instead of creating one, which can be
+        /// the output of another stack. The cluster must be `ACTIVE`, which is checked once the ARN is known. Its
+        /// Container Insights, capacity provider and ECS Exec settings are left untouched, so the service runs with
+        /// the `FARGATE` launch type and `compute.capacity`, `cluster` and `executeCommand` can't be used.
+        /// </summary>
+        [Input("ecsClusterArn")]
+        public Input<string>? EcsClusterArn { get; set; }
+
+        /// <summary>
+        /// The Metabase edition to run, either `oss` or `enterprise`.
+        /// </summary>
+        [Input("edition")]
+        public string? Edition { get; set; }
+
+        /// <summary>
+        /// Whether to enable ECS Exec, which lets you open a shell in the Metabase container with
+        /// `aws ecs execute-command`. The task role is given the SSM permissions the sessions need.
+        /// </summary>
+        [Input("enableExecuteCommand")]
+        public bool? EnableExecuteCommand { get; set; }
+
+        [Input("environment")]
+        private InputMap<string>? _environment;
+
+        /// <summary>
+        /// Additional environment variables for the Metabase container, for example any of the `MB_*`
+        /// [settings](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables). The
+        /// `MB_DB_*` variables are managed by the component and can't be set. The variable names must be known
+        /// before deployment, the values can be outputs of other resources.
+        /// </summary>
+        public InputMap<string> Environment
+        {
+            get => _environment ?? (_environment = new InputMap<string>());
+            set => _environment = value;
+        }
+
+        /// <summary>
+        /// Optionally encrypt and log ECS Exec sessions. Requires `enableExecuteCommand`.
+        /// </summary>
+        [Input("executeCommand")]
+        public Input<Inputs.ExecuteCommandArgs>? ExecuteCommand { get; set; }
+
+        /// <summary>
+        /// Whether to harden the Metabase container. It runs as the non-root `metabase` user (uid 2000) with a
+        /// read-only root filesystem, all Linux capabilities dropped, an open files limit of 65536 and 60 seconds
+        /// to shut down. Fargate doesn't support tmpfs, so the paths Metabase writes to, `/tmp` and the plugins
+        /// directory, are stored on an EFS file system with a mount target in each of the ECS subnets, which must
+        /// be in different availability zones and known before deployment. Requires an image that runs as the
+        /// `metabase` user such as the official images, and can't be used with `enableExecuteCommand`. The FireLens
+        /// and sidecar containers aren't changed. Defaults to `false`.
+        /// </summary>
+        [Input("hardened")]
+        public bool? Hardened { get; set; }
+
+        /// <summary>
+        /// Optionally tune the health checks run against the Metabase container.
+        /// </summary>
+        [Input("healthCheck")]
+        public Input<Inputs.HealthCheckArgs>? HealthCheck { get; set; }
+
+        /// <summary>
+        /// A full image reference, such as `registry.example.com/metabase:v0.46.6-drivers`, to run instead of the
+        /// official Metabase image. The image is used as is, `metabaseVersion` doesn't change its tag. Can't be used
+        /// with `imageMirror`. The container health check runs `curl`, which a custom image may not ship, so only
+        /// the load balancer health checks a custom image.
+        /// </summary>
+        [Input("image")]
+        public Input<string>? Image { get; set; }
+
+        /// <summary>
+        /// Optionally mirror the Metabase image in a private ECR registry.
+        /// </summary>
+        [Input("imageMirror")]
+        public Input<Inputs.ImageMirrorArgs>? ImageMirror { get; set; }
+
+        /// <summary>
+        /// Optional arguments for configuring the Metabase container logs.
+        /// </summary>
+        [Input("logging")]
+        public Input<Inputs.LoggingArgs>? Logging { get; set; }
+
+        /// <summary>
+        /// The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image, or the
+        /// `metabase/metabase-enterprise` image for the `enterprise` edition. Open source tags look like `v0.46.6`
+        /// and enterprise tags look like `v1.46.6`. When the version changes, a manual snapshot of the application
+        /// database named `&lt;name&gt;metabase-&lt;old version&gt;-to-&lt;new version&gt;` is taken before the new version is deployed.
+        /// When the image is pinned with `pinImageDigest`, a tag that moved to a new image, such as `latest`, is
+        /// snapshotted too and the versions in the name are followed by the first 12 characters of their digests.
+        /// Unpinned images aren't snapshotted when their tag moves. The snapshot is kept when the component is updated
+        /// again and has to be deleted by hand.
         /// </summary>
         [Input("metabaseVersion")]
         public Input<string>? MetabaseVersion { get; set; }
@@ -152,6 +288,102 @@ namespace Pulumi.Metabase
         [Input("networking")]
         public Input<Inputs.NetworkingArgs>? Networking { get; set; }
 
+        /// <summary>
+        /// Whether to resolve the image tag to its `sha256` digest at deploy time and run that digest, so every task
+        /// runs the same image and a change to the tag shows up as a diff. Images in a private ECR registry are
+        /// looked up with the ECR API, and aren't pinned when the registry is in another region. Images that need
+        /// `repositoryCredentials` aren't pinned.
+        /// </summary>
+        [Input("pinImageDigest")]
+        public bool? PinImageDigest { get; set; }
+
+        [Input("plugins")]
+        private List<Input<Inputs.PluginArgs>>? _plugins;
+
+        /// <summary>
+        /// Plugin JARs to install in Metabase's plugins directory (`MB_PLUGINS_DIR`), such as the ClickHouse or DuckDB
+        /// community drivers. The plugins directory is stored on an EFS file system with a mount target in each of
+        /// the ECS subnets, which must be in different availability zones and known before deployment. An init
+        /// container downloads any missing plugin and checks its checksum before Metabase starts, and removes the
+        /// plugins it installed that are no longer listed.
+        /// </summary>
+        public List<Input<Inputs.PluginArgs>> Plugins
+        {
+            get => _plugins ?? (_plugins = new List<Input<Inputs.PluginArgs>>());
+            set => _plugins = value;
+        }
+
+        [Input("premiumEmbeddingToken")]
+        private Input<string>? _premiumEmbeddingToken;
+
+        /// <summary>
+        /// The Metabase Enterprise token, passed to the container as `MB_PREMIUM_EMBEDDING_TOKEN` through a
+        /// Secrets Manager secret. Requires the `enterprise` edition.
+        /// </summary>
+        public Input<string>? PremiumEmbeddingToken
+        {
+            get => _premiumEmbeddingToken;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _premiumEmbeddingToken = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The ARN of a Secrets Manager secret holding the `username` and `password` of the private registry hosting
+        /// `image`. The task execution role is granted access to the secret.
+        /// </summary>
+        [Input("repositoryCredentials")]
+        public Input<string>? RepositoryCredentials { get; set; }
+
+        /// <summary>
+        /// Optionally stop Metabase outside working hours.
+        /// </summary>
+        [Input("schedule")]
+        public Input<Inputs.ScheduleArgs>? Schedule { get; set; }
+
+        [Input("secrets")]
+        private InputMap<string>? _secrets;
+
+        /// <summary>
+        /// Additional environment variables for the Metabase container whose values are read from Secrets
+        /// Manager or SSM Parameter Store, as a map of variable name to secret ARN. The task execution role is
+        /// granted access to these secrets. The `MB_DB_*` variables are managed by the component and can't be set.
+        /// The variable names must be known before deployment, the ARNs can be outputs of other resources such as
+        /// a secret created in the same program.
+        /// </summary>
+        public InputMap<string> Secrets
+        {
+            get => _secrets ?? (_secrets = new InputMap<string>());
+            set => _secrets = value;
+        }
+
+        /// <summary>
+        /// Optionally configure commonly used Metabase application settings.
+        /// </summary>
+        [Input("settings")]
+        public Input<Inputs.SettingsArgs>? Settings { get; set; }
+
+        [Input("sidecars")]
+        private List<Input<Inputs.SidecarArgs>>? _sidecars;
+
+        /// <summary>
+        /// Extra containers to run in the Metabase task. Their logs are sent to the Metabase log group with the
+        /// container name as the stream prefix.
+        /// </summary>
+        public List<Input<Inputs.SidecarArgs>> Sidecars
+        {
+            get => _sidecars ?? (_sidecars = new List<Input<Inputs.SidecarArgs>>());
+            set => _sidecars = value;
+        }
+
+        /// <summary>
+        /// Optionally give the Metabase container IAM permissions.
+        /// </summary>
+        [Input("taskRole")]
+        public Input<Inputs.TaskRoleArgs>? TaskRole { get; set; }
+
         /// <summary>
         /// The VPC to use for the Metabase service. If left blank then the default VPC will be used.
         /// </summary>
@@ -160,6 +392,8 @@ namespace Pulumi.Metabase
 
         public MetabaseArgs()
         {
+            Edition = "oss";
+            PinImageDigest = true;
         }
     }
 }
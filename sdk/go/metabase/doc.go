@@ -1,3 +1,2 @@
 // Package metabase exports types, functions, subpackages for provisioning metabase resources.
-//
 package metabase
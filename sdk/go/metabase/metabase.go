@@ -24,20 +24,23 @@ import (
 // package main
 //
 // import (
-//   "github.com/pulumi/pulumi-metabase/sdk/go/metabase"
-//   "github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+//	"github.com/pulumi/pulumi-metabase/sdk/go/metabase"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
 // )
 //
-// func main() {
-//   pulumi.Run(func(ctx *pulumi.Context) error {
-//     metabaseService, err := metabase.NewMetabase(ctx, "metabaseService", nil)
-//     if err != nil {
-//       return err
-//     }
-//     ctx.Export("url", metabaseService.DnsName)
-//     return nil
-//   })
-// }
+//	func main() {
+//	  pulumi.Run(func(ctx *pulumi.Context) error {
+//	    metabaseService, err := metabase.NewMetabase(ctx, "metabaseService", nil)
+//	    if err != nil {
+//	      return err
+//	    }
+//	    ctx.Export("url", metabaseService.DnsName)
+//	    return nil
+//	  })
+//	}
+//
 // ```
 // {{ /example }}
 // ### Custom Domain & Networking
@@ -46,40 +49,43 @@ import (
 // package main
 //
 // import (
-//   "github.com/pulumi/pulumi-metabase/sdk/go/metabase"
-//   "github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
+//	"github.com/pulumi/pulumi-metabase/sdk/go/metabase"
+//	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+//
 // )
 //
-// func main() {
-//   pulumi.Run(func(ctx *pulumi.Context) error {
-//     metabaseService, err := metabase.NewMetabase(ctx, "metabaseService", &metabase.MetabaseArgs{
-//       VpcId: pulumi.String("vpc-123"),
-//       Networking: &metabase.NetworkingArgs{
-//         EcsSubnetIds: pulumi.StringArray{
-//           pulumi.String("subnet-123"),
-//           pulumi.String("subnet-456"),
-//         },
-//         DbSubnetIds: pulumi.StringArray{
-//           pulumi.String("subnet-789"),
-//           pulumi.String("subnet-abc"),
-//         },
-//         LbSubnetIds: pulumi.StringArray{
-//           pulumi.String("subnet-def"),
-//           pulumi.String("subnet-ghi"),
-//         },
-//       },
-//       Domain: &metabase.CustomDomainArgs{
-//         HostedZoneName: pulumi.String("example.com"),
-//         DomainName:     pulumi.String("metabase.example.com"),
-//       },
-//     })
-//     if err != nil {
-//       return err
-//     }
-//     ctx.Export("url", metabaseService.DnsName)
-//     return nil
-//   })
-// }
+//	func main() {
+//	  pulumi.Run(func(ctx *pulumi.Context) error {
+//	    metabaseService, err := metabase.NewMetabase(ctx, "metabaseService", &metabase.MetabaseArgs{
+//	      VpcId: pulumi.String("vpc-123"),
+//	      Networking: &metabase.NetworkingArgs{
+//	        EcsSubnetIds: pulumi.StringArray{
+//	          pulumi.String("subnet-123"),
+//	          pulumi.String("subnet-456"),
+//	        },
+//	        DbSubnetIds: pulumi.StringArray{
+//	          pulumi.String("subnet-789"),
+//	          pulumi.String("subnet-abc"),
+//	        },
+//	        LbSubnetIds: pulumi.StringArray{
+//	          pulumi.String("subnet-def"),
+//	          pulumi.String("subnet-ghi"),
+//	        },
+//	      },
+//	      Domain: &metabase.CustomDomainArgs{
+//	        HostedZoneName: pulumi.String("example.com"),
+//	        DomainName:     pulumi.String("metabase.example.com"),
+//	      },
+//	    })
+//	    if err != nil {
+//	      return err
+//	    }
+//	    ctx.Export("url", metabaseService.DnsName)
+//	    return nil
+//	  })
+//	}
+//
 // ```
 // {{ /example }}
 type Metabase struct {
@@ -87,8 +93,16 @@ type Metabase struct {
 
 	// The DNS name for the Metabase instance.
 	DnsName pulumi.StringOutput `pulumi:"dnsName"`
+	// The digest the Metabase image is pinned to, empty when the image isn't pinned.
+	ImageDigest pulumi.StringOutput `pulumi:"imageDigest"`
+	// The repository the Metabase image is pulled from.
+	ImageRepository pulumi.StringOutput `pulumi:"imageRepository"`
+	// The name of the CloudWatch log group the Metabase container logs are sent to.
+	LogGroupName pulumi.StringOutput `pulumi:"logGroupName"`
 	// The security group id for the Metabase instance.
 	SecurityGroupId pulumi.StringOutput `pulumi:"securityGroupId"`
+	// The ARN of the IAM role assumed by the Metabase container.
+	TaskRoleArn pulumi.StringOutput `pulumi:"taskRoleArn"`
 }
 
 // NewMetabase registers a new resource with the given unique name, arguments, and options.
@@ -98,8 +112,25 @@ func NewMetabase(ctx *pulumi.Context,
 		args = &MetabaseArgs{}
 	}
 
-	if args.Database != nil {
-		args.Database = args.Database.ToDatabasePtrOutput().ApplyT(func(v *Database) *Database { return v.Defaults() }).(DatabasePtrOutput)
+	if isZero(args.Edition) {
+		edition_ := "oss"
+		args.Edition = &edition_
+	}
+	if args.HealthCheck != nil {
+		args.HealthCheck = args.HealthCheck.ToHealthCheckPtrOutput().ApplyT(func(v *HealthCheck) *HealthCheck { return v.Defaults() }).(HealthCheckPtrOutput)
+	}
+	if args.ImageMirror != nil {
+		args.ImageMirror = args.ImageMirror.ToImageMirrorPtrOutput().ApplyT(func(v *ImageMirror) *ImageMirror { return v.Defaults() }).(ImageMirrorPtrOutput)
+	}
+	if args.Logging != nil {
+		args.Logging = args.Logging.ToLoggingPtrOutput().ApplyT(func(v *Logging) *Logging { return v.Defaults() }).(LoggingPtrOutput)
+	}
+	if isZero(args.PinImageDigest) {
+		pinImageDigest_ := true
+		args.PinImageDigest = &pinImageDigest_
+	}
+	if args.PremiumEmbeddingToken != nil {
+		args.PremiumEmbeddingToken = pulumi.ToSecret(args.PremiumEmbeddingToken).(pulumi.StringPtrOutput)
 	}
 	var resource Metabase
 	err := ctx.RegisterRemoteComponentResource("metabase:index:Metabase", name, args, &resource, opts...)
@@ -110,28 +141,200 @@ func NewMetabase(ctx *pulumi.Context,
 }
 
 type metabaseArgs struct {
+	// Metabase's database migrations only run forwards, so deploying an older `metabaseVersion` than the one
+	// last deployed is refused. The deployed version is recorded in the SSM parameter
+	// `/pulumi/metabase/<project>/<stack>/<name>/version`. A deployed tag that doesn't name a release, such as
+	// `latest`, can't be compared, so moving from it to a version only logs a warning. Set this to deploy an older
+	// version anyway, typically after restoring a database snapshot taken before the upgrade.
+	AllowDowngrade *bool `pulumi:"allowDowngrade"`
+	// Optionally configure the ECS cluster created for Metabase, such as enabling Container Insights. Can't be
+	// used with `ecsClusterArn`.
+	Cluster *Cluster `pulumi:"cluster"`
+	// Optionally configure the Fargate compute Metabase runs on.
+	Compute *Compute `pulumi:"compute"`
 	// Optional arguments for configuring your RDS instance.
 	Database *Database `pulumi:"database"`
 	// Optionally provide a hosted zone and domain name for the Metabase service.
 	Domain *CustomDomain `pulumi:"domain"`
-	// The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image.
+	// The ARN of an existing ECS cluster to run the Metabase service on instead of creating one, which can be
+	// the output of another stack. The cluster must be `ACTIVE`, which is checked once the ARN is known. Its
+	// Container Insights, capacity provider and ECS Exec settings are left untouched, so the service runs with
+	// the `FARGATE` launch type and `compute.capacity`, `cluster` and `executeCommand` can't be used.
+	EcsClusterArn *string `pulumi:"ecsClusterArn"`
+	// The Metabase edition to run, either `oss` or `enterprise`.
+	Edition *string `pulumi:"edition"`
+	// Whether to enable ECS Exec, which lets you open a shell in the Metabase container with
+	// `aws ecs execute-command`. The task role is given the SSM permissions the sessions need.
+	EnableExecuteCommand *bool `pulumi:"enableExecuteCommand"`
+	// Additional environment variables for the Metabase container, for example any of the `MB_*`
+	// [settings](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables). The
+	// `MB_DB_*` variables are managed by the component and can't be set. The variable names must be known
+	// before deployment, the values can be outputs of other resources.
+	Environment map[string]string `pulumi:"environment"`
+	// Optionally encrypt and log ECS Exec sessions. Requires `enableExecuteCommand`.
+	ExecuteCommand *ExecuteCommand `pulumi:"executeCommand"`
+	// Whether to harden the Metabase container. It runs as the non-root `metabase` user (uid 2000) with a
+	// read-only root filesystem, all Linux capabilities dropped, an open files limit of 65536 and 60 seconds
+	// to shut down. Fargate doesn't support tmpfs, so the paths Metabase writes to, `/tmp` and the plugins
+	// directory, are stored on an EFS file system with a mount target in each of the ECS subnets, which must
+	// be in different availability zones and known before deployment. Requires an image that runs as the
+	// `metabase` user such as the official images, and can't be used with `enableExecuteCommand`. The FireLens
+	// and sidecar containers aren't changed. Defaults to `false`.
+	Hardened *bool `pulumi:"hardened"`
+	// Optionally tune the health checks run against the Metabase container.
+	HealthCheck *HealthCheck `pulumi:"healthCheck"`
+	// A full image reference, such as `registry.example.com/metabase:v0.46.6-drivers`, to run instead of the
+	// official Metabase image. The image is used as is, `metabaseVersion` doesn't change its tag. Can't be used
+	// with `imageMirror`. The container health check runs `curl`, which a custom image may not ship, so only
+	// the load balancer health checks a custom image.
+	Image *string `pulumi:"image"`
+	// Optionally mirror the Metabase image in a private ECR registry.
+	ImageMirror *ImageMirror `pulumi:"imageMirror"`
+	// Optional arguments for configuring the Metabase container logs.
+	Logging *Logging `pulumi:"logging"`
+	// The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image, or the
+	// `metabase/metabase-enterprise` image for the `enterprise` edition. Open source tags look like `v0.46.6`
+	// and enterprise tags look like `v1.46.6`. When the version changes, a manual snapshot of the application
+	// database named `<name>metabase-<old version>-to-<new version>` is taken before the new version is deployed.
+	// When the image is pinned with `pinImageDigest`, a tag that moved to a new image, such as `latest`, is
+	// snapshotted too and the versions in the name are followed by the first 12 characters of their digests.
+	// Unpinned images aren't snapshotted when their tag moves. The snapshot is kept when the component is updated
+	// again and has to be deleted by hand.
 	MetabaseVersion *string `pulumi:"metabaseVersion"`
 	// Optionally provide specific subnet IDs to run the different resources of Metabase.
 	Networking *Networking `pulumi:"networking"`
+	// Whether to resolve the image tag to its `sha256` digest at deploy time and run that digest, so every task
+	// runs the same image and a change to the tag shows up as a diff. Images in a private ECR registry are
+	// looked up with the ECR API, and aren't pinned when the registry is in another region. Images that need
+	// `repositoryCredentials` aren't pinned.
+	PinImageDigest *bool `pulumi:"pinImageDigest"`
+	// Plugin JARs to install in Metabase's plugins directory (`MB_PLUGINS_DIR`), such as the ClickHouse or DuckDB
+	// community drivers. The plugins directory is stored on an EFS file system with a mount target in each of
+	// the ECS subnets, which must be in different availability zones and known before deployment. An init
+	// container downloads any missing plugin and checks its checksum before Metabase starts, and removes the
+	// plugins it installed that are no longer listed.
+	Plugins []Plugin `pulumi:"plugins"`
+	// The Metabase Enterprise token, passed to the container as `MB_PREMIUM_EMBEDDING_TOKEN` through a
+	// Secrets Manager secret. Requires the `enterprise` edition.
+	PremiumEmbeddingToken *string `pulumi:"premiumEmbeddingToken"`
+	// The ARN of a Secrets Manager secret holding the `username` and `password` of the private registry hosting
+	// `image`. The task execution role is granted access to the secret.
+	RepositoryCredentials *string `pulumi:"repositoryCredentials"`
+	// Optionally stop Metabase outside working hours.
+	Schedule *Schedule `pulumi:"schedule"`
+	// Additional environment variables for the Metabase container whose values are read from Secrets
+	// Manager or SSM Parameter Store, as a map of variable name to secret ARN. The task execution role is
+	// granted access to these secrets. The `MB_DB_*` variables are managed by the component and can't be set.
+	// The variable names must be known before deployment, the ARNs can be outputs of other resources such as
+	// a secret created in the same program.
+	Secrets map[string]string `pulumi:"secrets"`
+	// Optionally configure commonly used Metabase application settings.
+	Settings *Settings `pulumi:"settings"`
+	// Extra containers to run in the Metabase task. Their logs are sent to the Metabase log group with the
+	// container name as the stream prefix.
+	Sidecars []Sidecar `pulumi:"sidecars"`
+	// Optionally give the Metabase container IAM permissions.
+	TaskRole *TaskRole `pulumi:"taskRole"`
 	// The VPC to use for the Metabase service. If left blank then the default VPC will be used.
 	VpcId *string `pulumi:"vpcId"`
 }
 
 // The set of arguments for constructing a Metabase resource.
 type MetabaseArgs struct {
+	// Metabase's database migrations only run forwards, so deploying an older `metabaseVersion` than the one
+	// last deployed is refused. The deployed version is recorded in the SSM parameter
+	// `/pulumi/metabase/<project>/<stack>/<name>/version`. A deployed tag that doesn't name a release, such as
+	// `latest`, can't be compared, so moving from it to a version only logs a warning. Set this to deploy an older
+	// version anyway, typically after restoring a database snapshot taken before the upgrade.
+	AllowDowngrade *bool
+	// Optionally configure the ECS cluster created for Metabase, such as enabling Container Insights. Can't be
+	// used with `ecsClusterArn`.
+	Cluster ClusterPtrInput
+	// Optionally configure the Fargate compute Metabase runs on.
+	Compute ComputePtrInput
 	// Optional arguments for configuring your RDS instance.
 	Database DatabasePtrInput
 	// Optionally provide a hosted zone and domain name for the Metabase service.
 	Domain CustomDomainPtrInput
-	// The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image.
+	// The ARN of an existing ECS cluster to run the Metabase service on instead of creating one, which can be
+	// the output of another stack. The cluster must be `ACTIVE`, which is checked once the ARN is known. Its
+	// Container Insights, capacity provider and ECS Exec settings are left untouched, so the service runs with
+	// the `FARGATE` launch type and `compute.capacity`, `cluster` and `executeCommand` can't be used.
+	EcsClusterArn pulumi.StringPtrInput
+	// The Metabase edition to run, either `oss` or `enterprise`.
+	Edition *string
+	// Whether to enable ECS Exec, which lets you open a shell in the Metabase container with
+	// `aws ecs execute-command`. The task role is given the SSM permissions the sessions need.
+	EnableExecuteCommand *bool
+	// Additional environment variables for the Metabase container, for example any of the `MB_*`
+	// [settings](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables). The
+	// `MB_DB_*` variables are managed by the component and can't be set. The variable names must be known
+	// before deployment, the values can be outputs of other resources.
+	Environment pulumi.StringMapInput
+	// Optionally encrypt and log ECS Exec sessions. Requires `enableExecuteCommand`.
+	ExecuteCommand ExecuteCommandPtrInput
+	// Whether to harden the Metabase container. It runs as the non-root `metabase` user (uid 2000) with a
+	// read-only root filesystem, all Linux capabilities dropped, an open files limit of 65536 and 60 seconds
+	// to shut down. Fargate doesn't support tmpfs, so the paths Metabase writes to, `/tmp` and the plugins
+	// directory, are stored on an EFS file system with a mount target in each of the ECS subnets, which must
+	// be in different availability zones and known before deployment. Requires an image that runs as the
+	// `metabase` user such as the official images, and can't be used with `enableExecuteCommand`. The FireLens
+	// and sidecar containers aren't changed. Defaults to `false`.
+	Hardened *bool
+	// Optionally tune the health checks run against the Metabase container.
+	HealthCheck HealthCheckPtrInput
+	// A full image reference, such as `registry.example.com/metabase:v0.46.6-drivers`, to run instead of the
+	// official Metabase image. The image is used as is, `metabaseVersion` doesn't change its tag. Can't be used
+	// with `imageMirror`. The container health check runs `curl`, which a custom image may not ship, so only
+	// the load balancer health checks a custom image.
+	Image pulumi.StringPtrInput
+	// Optionally mirror the Metabase image in a private ECR registry.
+	ImageMirror ImageMirrorPtrInput
+	// Optional arguments for configuring the Metabase container logs.
+	Logging LoggingPtrInput
+	// The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image, or the
+	// `metabase/metabase-enterprise` image for the `enterprise` edition. Open source tags look like `v0.46.6`
+	// and enterprise tags look like `v1.46.6`. When the version changes, a manual snapshot of the application
+	// database named `<name>metabase-<old version>-to-<new version>` is taken before the new version is deployed.
+	// When the image is pinned with `pinImageDigest`, a tag that moved to a new image, such as `latest`, is
+	// snapshotted too and the versions in the name are followed by the first 12 characters of their digests.
+	// Unpinned images aren't snapshotted when their tag moves. The snapshot is kept when the component is updated
+	// again and has to be deleted by hand.
 	MetabaseVersion pulumi.StringPtrInput
 	// Optionally provide specific subnet IDs to run the different resources of Metabase.
 	Networking NetworkingPtrInput
+	// Whether to resolve the image tag to its `sha256` digest at deploy time and run that digest, so every task
+	// runs the same image and a change to the tag shows up as a diff. Images in a private ECR registry are
+	// looked up with the ECR API, and aren't pinned when the registry is in another region. Images that need
+	// `repositoryCredentials` aren't pinned.
+	PinImageDigest *bool
+	// Plugin JARs to install in Metabase's plugins directory (`MB_PLUGINS_DIR`), such as the ClickHouse or DuckDB
+	// community drivers. The plugins directory is stored on an EFS file system with a mount target in each of
+	// the ECS subnets, which must be in different availability zones and known before deployment. An init
+	// container downloads any missing plugin and checks its checksum before Metabase starts, and removes the
+	// plugins it installed that are no longer listed.
+	Plugins []PluginInput
+	// The Metabase Enterprise token, passed to the container as `MB_PREMIUM_EMBEDDING_TOKEN` through a
+	// Secrets Manager secret. Requires the `enterprise` edition.
+	PremiumEmbeddingToken pulumi.StringPtrInput
+	// The ARN of a Secrets Manager secret holding the `username` and `password` of the private registry hosting
+	// `image`. The task execution role is granted access to the secret.
+	RepositoryCredentials pulumi.StringPtrInput
+	// Optionally stop Metabase outside working hours.
+	Schedule SchedulePtrInput
+	// Additional environment variables for the Metabase container whose values are read from Secrets
+	// Manager or SSM Parameter Store, as a map of variable name to secret ARN. The task execution role is
+	// granted access to these secrets. The `MB_DB_*` variables are managed by the component and can't be set.
+	// The variable names must be known before deployment, the ARNs can be outputs of other resources such as
+	// a secret created in the same program.
+	Secrets pulumi.StringMapInput
+	// Optionally configure commonly used Metabase application settings.
+	Settings SettingsPtrInput
+	// Extra containers to run in the Metabase task. Their logs are sent to the Metabase log group with the
+	// container name as the stream prefix.
+	Sidecars []SidecarInput
+	// Optionally give the Metabase container IAM permissions.
+	TaskRole TaskRolePtrInput
 	// The VPC to use for the Metabase service. If left blank then the default VPC will be used.
 	VpcId pulumi.StringPtrInput
 }
@@ -162,7 +365,7 @@ func (i *Metabase) ToMetabaseOutputWithContext(ctx context.Context) MetabaseOutp
 // MetabaseArrayInput is an input type that accepts MetabaseArray and MetabaseArrayOutput values.
 // You can construct a concrete instance of `MetabaseArrayInput` via:
 //
-//          MetabaseArray{ MetabaseArgs{...} }
+//	MetabaseArray{ MetabaseArgs{...} }
 type MetabaseArrayInput interface {
 	pulumi.Input
 
@@ -187,7 +390,7 @@ func (i MetabaseArray) ToMetabaseArrayOutputWithContext(ctx context.Context) Met
 // MetabaseMapInput is an input type that accepts MetabaseMap and MetabaseMapOutput values.
 // You can construct a concrete instance of `MetabaseMapInput` via:
 //
-//          MetabaseMap{ "key": MetabaseArgs{...} }
+//	MetabaseMap{ "key": MetabaseArgs{...} }
 type MetabaseMapInput interface {
 	pulumi.Input
 
@@ -10,515 +10,3525 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// An item of a cluster's default capacity provider strategy.
+type CapacityProviderStrategy struct {
+	// The number of tasks to run on the capacity provider before the weights apply. Only one capacity
+	// provider can have a base. Defaults to `0`.
+	Base *int `pulumi:"base"`
+	// The capacity provider, either `FARGATE` or `FARGATE_SPOT`.
+	CapacityProvider string `pulumi:"capacityProvider"`
+	// The relative share of tasks launched on the capacity provider. Defaults to `0`.
+	Weight *int `pulumi:"weight"`
+}
+
+// CapacityProviderStrategyInput is an input type that accepts CapacityProviderStrategyArgs and CapacityProviderStrategyOutput values.
+// You can construct a concrete instance of `CapacityProviderStrategyInput` via:
+//
+//	CapacityProviderStrategyArgs{...}
+type CapacityProviderStrategyInput interface {
+	pulumi.Input
+
+	ToCapacityProviderStrategyOutput() CapacityProviderStrategyOutput
+	ToCapacityProviderStrategyOutputWithContext(context.Context) CapacityProviderStrategyOutput
+}
+
+// An item of a cluster's default capacity provider strategy.
+type CapacityProviderStrategyArgs struct {
+	// The number of tasks to run on the capacity provider before the weights apply. Only one capacity
+	// provider can have a base. Defaults to `0`.
+	Base *int `pulumi:"base"`
+	// The capacity provider, either `FARGATE` or `FARGATE_SPOT`.
+	CapacityProvider string `pulumi:"capacityProvider"`
+	// The relative share of tasks launched on the capacity provider. Defaults to `0`.
+	Weight *int `pulumi:"weight"`
+}
+
+func (CapacityProviderStrategyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CapacityProviderStrategy)(nil)).Elem()
+}
+
+func (i CapacityProviderStrategyArgs) ToCapacityProviderStrategyOutput() CapacityProviderStrategyOutput {
+	return i.ToCapacityProviderStrategyOutputWithContext(context.Background())
+}
+
+func (i CapacityProviderStrategyArgs) ToCapacityProviderStrategyOutputWithContext(ctx context.Context) CapacityProviderStrategyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CapacityProviderStrategyOutput)
+}
+
+// CapacityProviderStrategyArrayInput is an input type that accepts CapacityProviderStrategyArray and CapacityProviderStrategyArrayOutput values.
+// You can construct a concrete instance of `CapacityProviderStrategyArrayInput` via:
+//
+//	CapacityProviderStrategyArray{ CapacityProviderStrategyArgs{...} }
+type CapacityProviderStrategyArrayInput interface {
+	pulumi.Input
+
+	ToCapacityProviderStrategyArrayOutput() CapacityProviderStrategyArrayOutput
+	ToCapacityProviderStrategyArrayOutputWithContext(context.Context) CapacityProviderStrategyArrayOutput
+}
+
+type CapacityProviderStrategyArray []CapacityProviderStrategyInput
+
+func (CapacityProviderStrategyArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CapacityProviderStrategy)(nil)).Elem()
+}
+
+func (i CapacityProviderStrategyArray) ToCapacityProviderStrategyArrayOutput() CapacityProviderStrategyArrayOutput {
+	return i.ToCapacityProviderStrategyArrayOutputWithContext(context.Background())
+}
+
+func (i CapacityProviderStrategyArray) ToCapacityProviderStrategyArrayOutputWithContext(ctx context.Context) CapacityProviderStrategyArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CapacityProviderStrategyArrayOutput)
+}
+
+// An item of a cluster's default capacity provider strategy.
+type CapacityProviderStrategyOutput struct{ *pulumi.OutputState }
+
+func (CapacityProviderStrategyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CapacityProviderStrategy)(nil)).Elem()
+}
+
+func (o CapacityProviderStrategyOutput) ToCapacityProviderStrategyOutput() CapacityProviderStrategyOutput {
+	return o
+}
+
+func (o CapacityProviderStrategyOutput) ToCapacityProviderStrategyOutputWithContext(ctx context.Context) CapacityProviderStrategyOutput {
+	return o
+}
+
+// The number of tasks to run on the capacity provider before the weights apply. Only one capacity
+// provider can have a base. Defaults to `0`.
+func (o CapacityProviderStrategyOutput) Base() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CapacityProviderStrategy) *int { return v.Base }).(pulumi.IntPtrOutput)
+}
+
+// The capacity provider, either `FARGATE` or `FARGATE_SPOT`.
+func (o CapacityProviderStrategyOutput) CapacityProvider() pulumi.StringOutput {
+	return o.ApplyT(func(v CapacityProviderStrategy) string { return v.CapacityProvider }).(pulumi.StringOutput)
+}
+
+// The relative share of tasks launched on the capacity provider. Defaults to `0`.
+func (o CapacityProviderStrategyOutput) Weight() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CapacityProviderStrategy) *int { return v.Weight }).(pulumi.IntPtrOutput)
+}
+
+type CapacityProviderStrategyArrayOutput struct{ *pulumi.OutputState }
+
+func (CapacityProviderStrategyArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CapacityProviderStrategy)(nil)).Elem()
+}
+
+func (o CapacityProviderStrategyArrayOutput) ToCapacityProviderStrategyArrayOutput() CapacityProviderStrategyArrayOutput {
+	return o
+}
+
+func (o CapacityProviderStrategyArrayOutput) ToCapacityProviderStrategyArrayOutputWithContext(ctx context.Context) CapacityProviderStrategyArrayOutput {
+	return o
+}
+
+func (o CapacityProviderStrategyArrayOutput) Index(i pulumi.IntInput) CapacityProviderStrategyOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CapacityProviderStrategy {
+		return vs[0].([]CapacityProviderStrategy)[vs[1].(int)]
+	}).(CapacityProviderStrategyOutput)
+}
+
+// Settings of the ECS cluster created for Metabase.
+type Cluster struct {
+	// Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+	// storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+	ContainerInsights *bool `pulumi:"containerInsights"`
+	// The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+	// of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+	// type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+	// associated with the cluster when this is set.
+	DefaultCapacityProviderStrategy []CapacityProviderStrategy `pulumi:"defaultCapacityProviderStrategy"`
+	// Tags to apply to the cluster.
+	Tags map[string]string `pulumi:"tags"`
+}
+
+// ClusterInput is an input type that accepts ClusterArgs and ClusterOutput values.
+// You can construct a concrete instance of `ClusterInput` via:
+//
+//	ClusterArgs{...}
+type ClusterInput interface {
+	pulumi.Input
+
+	ToClusterOutput() ClusterOutput
+	ToClusterOutputWithContext(context.Context) ClusterOutput
+}
+
+// Settings of the ECS cluster created for Metabase.
+type ClusterArgs struct {
+	// Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+	// storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+	ContainerInsights *bool `pulumi:"containerInsights"`
+	// The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+	// of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+	// type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+	// associated with the cluster when this is set.
+	DefaultCapacityProviderStrategy []CapacityProviderStrategyInput `pulumi:"defaultCapacityProviderStrategy"`
+	// Tags to apply to the cluster.
+	Tags pulumi.StringMapInput `pulumi:"tags"`
+}
+
+func (ClusterArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Cluster)(nil)).Elem()
+}
+
+func (i ClusterArgs) ToClusterOutput() ClusterOutput {
+	return i.ToClusterOutputWithContext(context.Background())
+}
+
+func (i ClusterArgs) ToClusterOutputWithContext(ctx context.Context) ClusterOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterOutput)
+}
+
+func (i ClusterArgs) ToClusterPtrOutput() ClusterPtrOutput {
+	return i.ToClusterPtrOutputWithContext(context.Background())
+}
+
+func (i ClusterArgs) ToClusterPtrOutputWithContext(ctx context.Context) ClusterPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterOutput).ToClusterPtrOutputWithContext(ctx)
+}
+
+// ClusterPtrInput is an input type that accepts ClusterArgs, ClusterPtr and ClusterPtrOutput values.
+// You can construct a concrete instance of `ClusterPtrInput` via:
+//
+//	        ClusterArgs{...}
+//
+//	or:
+//
+//	        nil
+type ClusterPtrInput interface {
+	pulumi.Input
+
+	ToClusterPtrOutput() ClusterPtrOutput
+	ToClusterPtrOutputWithContext(context.Context) ClusterPtrOutput
+}
+
+type clusterPtrType ClusterArgs
+
+func ClusterPtr(v *ClusterArgs) ClusterPtrInput {
+	return (*clusterPtrType)(v)
+}
+
+func (*clusterPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Cluster)(nil)).Elem()
+}
+
+func (i *clusterPtrType) ToClusterPtrOutput() ClusterPtrOutput {
+	return i.ToClusterPtrOutputWithContext(context.Background())
+}
+
+func (i *clusterPtrType) ToClusterPtrOutputWithContext(ctx context.Context) ClusterPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterPtrOutput)
+}
+
+// Settings of the ECS cluster created for Metabase.
+type ClusterOutput struct{ *pulumi.OutputState }
+
+func (ClusterOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Cluster)(nil)).Elem()
+}
+
+func (o ClusterOutput) ToClusterOutput() ClusterOutput {
+	return o
+}
+
+func (o ClusterOutput) ToClusterOutputWithContext(ctx context.Context) ClusterOutput {
+	return o
+}
+
+func (o ClusterOutput) ToClusterPtrOutput() ClusterPtrOutput {
+	return o.ToClusterPtrOutputWithContext(context.Background())
+}
+
+func (o ClusterOutput) ToClusterPtrOutputWithContext(ctx context.Context) ClusterPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Cluster) *Cluster {
+		return &v
+	}).(ClusterPtrOutput)
+}
+
+// Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+// storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+func (o ClusterOutput) ContainerInsights() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Cluster) *bool { return v.ContainerInsights }).(pulumi.BoolPtrOutput)
+}
+
+// The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+// of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+// type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+// associated with the cluster when this is set.
+func (o ClusterOutput) DefaultCapacityProviderStrategy() CapacityProviderStrategyArrayOutput {
+	return o.ApplyT(func(v Cluster) []CapacityProviderStrategy { return v.DefaultCapacityProviderStrategy }).(CapacityProviderStrategyArrayOutput)
+}
+
+// Tags to apply to the cluster.
+func (o ClusterOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Cluster) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+}
+
+type ClusterPtrOutput struct{ *pulumi.OutputState }
+
+func (ClusterPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Cluster)(nil)).Elem()
+}
+
+func (o ClusterPtrOutput) ToClusterPtrOutput() ClusterPtrOutput {
+	return o
+}
+
+func (o ClusterPtrOutput) ToClusterPtrOutputWithContext(ctx context.Context) ClusterPtrOutput {
+	return o
+}
+
+func (o ClusterPtrOutput) Elem() ClusterOutput {
+	return o.ApplyT(func(v *Cluster) Cluster {
+		if v != nil {
+			return *v
+		}
+		var ret Cluster
+		return ret
+	}).(ClusterOutput)
+}
+
+// Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+// storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+func (o ClusterPtrOutput) ContainerInsights() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Cluster) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ContainerInsights
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+// of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+// type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+// associated with the cluster when this is set.
+func (o ClusterPtrOutput) DefaultCapacityProviderStrategy() CapacityProviderStrategyArrayOutput {
+	return o.ApplyT(func(v *Cluster) []CapacityProviderStrategy {
+		if v == nil {
+			return nil
+		}
+		return v.DefaultCapacityProviderStrategy
+	}).(CapacityProviderStrategyArrayOutput)
+}
+
+// Tags to apply to the cluster.
+func (o ClusterPtrOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Cluster) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Tags
+	}).(pulumi.StringMapOutput)
+}
+
+// Options for the Fargate compute the Metabase task runs on.
+type Compute struct {
+	// The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+	// published for the architecture, which is checked against the registry unless the image needs
+	// `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+	Architecture *string `pulumi:"architecture"`
+	// Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+	// task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+	// on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+	// non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+	// doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+	// `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+	Capacity *string `pulumi:"capacity"`
+}
+
+// ComputeInput is an input type that accepts ComputeArgs and ComputeOutput values.
+// You can construct a concrete instance of `ComputeInput` via:
+//
+//	ComputeArgs{...}
+type ComputeInput interface {
+	pulumi.Input
+
+	ToComputeOutput() ComputeOutput
+	ToComputeOutputWithContext(context.Context) ComputeOutput
+}
+
+// Options for the Fargate compute the Metabase task runs on.
+type ComputeArgs struct {
+	// The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+	// published for the architecture, which is checked against the registry unless the image needs
+	// `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+	Architecture *string `pulumi:"architecture"`
+	// Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+	// task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+	// on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+	// non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+	// doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+	// `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+	Capacity *string `pulumi:"capacity"`
+}
+
+func (ComputeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Compute)(nil)).Elem()
+}
+
+func (i ComputeArgs) ToComputeOutput() ComputeOutput {
+	return i.ToComputeOutputWithContext(context.Background())
+}
+
+func (i ComputeArgs) ToComputeOutputWithContext(ctx context.Context) ComputeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComputeOutput)
+}
+
+func (i ComputeArgs) ToComputePtrOutput() ComputePtrOutput {
+	return i.ToComputePtrOutputWithContext(context.Background())
+}
+
+func (i ComputeArgs) ToComputePtrOutputWithContext(ctx context.Context) ComputePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComputeOutput).ToComputePtrOutputWithContext(ctx)
+}
+
+// ComputePtrInput is an input type that accepts ComputeArgs, ComputePtr and ComputePtrOutput values.
+// You can construct a concrete instance of `ComputePtrInput` via:
+//
+//	        ComputeArgs{...}
+//
+//	or:
+//
+//	        nil
+type ComputePtrInput interface {
+	pulumi.Input
+
+	ToComputePtrOutput() ComputePtrOutput
+	ToComputePtrOutputWithContext(context.Context) ComputePtrOutput
+}
+
+type computePtrType ComputeArgs
+
+func ComputePtr(v *ComputeArgs) ComputePtrInput {
+	return (*computePtrType)(v)
+}
+
+func (*computePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Compute)(nil)).Elem()
+}
+
+func (i *computePtrType) ToComputePtrOutput() ComputePtrOutput {
+	return i.ToComputePtrOutputWithContext(context.Background())
+}
+
+func (i *computePtrType) ToComputePtrOutputWithContext(ctx context.Context) ComputePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComputePtrOutput)
+}
+
+// Options for the Fargate compute the Metabase task runs on.
+type ComputeOutput struct{ *pulumi.OutputState }
+
+func (ComputeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Compute)(nil)).Elem()
+}
+
+func (o ComputeOutput) ToComputeOutput() ComputeOutput {
+	return o
+}
+
+func (o ComputeOutput) ToComputeOutputWithContext(ctx context.Context) ComputeOutput {
+	return o
+}
+
+func (o ComputeOutput) ToComputePtrOutput() ComputePtrOutput {
+	return o.ToComputePtrOutputWithContext(context.Background())
+}
+
+func (o ComputeOutput) ToComputePtrOutputWithContext(ctx context.Context) ComputePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Compute) *Compute {
+		return &v
+	}).(ComputePtrOutput)
+}
+
+// The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+// published for the architecture, which is checked against the registry unless the image needs
+// `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+func (o ComputeOutput) Architecture() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Compute) *string { return v.Architecture }).(pulumi.StringPtrOutput)
+}
+
+// Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+// task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+// on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+// non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+// doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+// `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+func (o ComputeOutput) Capacity() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Compute) *string { return v.Capacity }).(pulumi.StringPtrOutput)
+}
+
+type ComputePtrOutput struct{ *pulumi.OutputState }
+
+func (ComputePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Compute)(nil)).Elem()
+}
+
+func (o ComputePtrOutput) ToComputePtrOutput() ComputePtrOutput {
+	return o
+}
+
+func (o ComputePtrOutput) ToComputePtrOutputWithContext(ctx context.Context) ComputePtrOutput {
+	return o
+}
+
+func (o ComputePtrOutput) Elem() ComputeOutput {
+	return o.ApplyT(func(v *Compute) Compute {
+		if v != nil {
+			return *v
+		}
+		var ret Compute
+		return ret
+	}).(ComputeOutput)
+}
+
+// The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+// published for the architecture, which is checked against the registry unless the image needs
+// `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+func (o ComputePtrOutput) Architecture() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Compute) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Architecture
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+// task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+// on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+// non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+// doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+// `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+func (o ComputePtrOutput) Capacity() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Compute) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Capacity
+	}).(pulumi.StringPtrOutput)
+}
+
+// A container a sidecar waits for before it's started.
+type ContainerDependency struct {
+	// The condition to wait for, one of `START`, `COMPLETE`, `SUCCESS` or `HEALTHY`.
+	Condition string `pulumi:"condition"`
+	// The name of the sidecar to wait for.
+	ContainerName string `pulumi:"containerName"`
+}
+
+// ContainerDependencyInput is an input type that accepts ContainerDependencyArgs and ContainerDependencyOutput values.
+// You can construct a concrete instance of `ContainerDependencyInput` via:
+//
+//	ContainerDependencyArgs{...}
+type ContainerDependencyInput interface {
+	pulumi.Input
+
+	ToContainerDependencyOutput() ContainerDependencyOutput
+	ToContainerDependencyOutputWithContext(context.Context) ContainerDependencyOutput
+}
+
+// A container a sidecar waits for before it's started.
+type ContainerDependencyArgs struct {
+	// The condition to wait for, one of `START`, `COMPLETE`, `SUCCESS` or `HEALTHY`.
+	Condition string `pulumi:"condition"`
+	// The name of the sidecar to wait for.
+	ContainerName string `pulumi:"containerName"`
+}
+
+func (ContainerDependencyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ContainerDependency)(nil)).Elem()
+}
+
+func (i ContainerDependencyArgs) ToContainerDependencyOutput() ContainerDependencyOutput {
+	return i.ToContainerDependencyOutputWithContext(context.Background())
+}
+
+func (i ContainerDependencyArgs) ToContainerDependencyOutputWithContext(ctx context.Context) ContainerDependencyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerDependencyOutput)
+}
+
+// ContainerDependencyArrayInput is an input type that accepts ContainerDependencyArray and ContainerDependencyArrayOutput values.
+// You can construct a concrete instance of `ContainerDependencyArrayInput` via:
+//
+//	ContainerDependencyArray{ ContainerDependencyArgs{...} }
+type ContainerDependencyArrayInput interface {
+	pulumi.Input
+
+	ToContainerDependencyArrayOutput() ContainerDependencyArrayOutput
+	ToContainerDependencyArrayOutputWithContext(context.Context) ContainerDependencyArrayOutput
+}
+
+type ContainerDependencyArray []ContainerDependencyInput
+
+func (ContainerDependencyArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerDependency)(nil)).Elem()
+}
+
+func (i ContainerDependencyArray) ToContainerDependencyArrayOutput() ContainerDependencyArrayOutput {
+	return i.ToContainerDependencyArrayOutputWithContext(context.Background())
+}
+
+func (i ContainerDependencyArray) ToContainerDependencyArrayOutputWithContext(ctx context.Context) ContainerDependencyArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ContainerDependencyArrayOutput)
+}
+
+// A container a sidecar waits for before it's started.
+type ContainerDependencyOutput struct{ *pulumi.OutputState }
+
+func (ContainerDependencyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ContainerDependency)(nil)).Elem()
+}
+
+func (o ContainerDependencyOutput) ToContainerDependencyOutput() ContainerDependencyOutput {
+	return o
+}
+
+func (o ContainerDependencyOutput) ToContainerDependencyOutputWithContext(ctx context.Context) ContainerDependencyOutput {
+	return o
+}
+
+// The condition to wait for, one of `START`, `COMPLETE`, `SUCCESS` or `HEALTHY`.
+func (o ContainerDependencyOutput) Condition() pulumi.StringOutput {
+	return o.ApplyT(func(v ContainerDependency) string { return v.Condition }).(pulumi.StringOutput)
+}
+
+// The name of the sidecar to wait for.
+func (o ContainerDependencyOutput) ContainerName() pulumi.StringOutput {
+	return o.ApplyT(func(v ContainerDependency) string { return v.ContainerName }).(pulumi.StringOutput)
+}
+
+type ContainerDependencyArrayOutput struct{ *pulumi.OutputState }
+
+func (ContainerDependencyArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ContainerDependency)(nil)).Elem()
+}
+
+func (o ContainerDependencyArrayOutput) ToContainerDependencyArrayOutput() ContainerDependencyArrayOutput {
+	return o
+}
+
+func (o ContainerDependencyArrayOutput) ToContainerDependencyArrayOutputWithContext(ctx context.Context) ContainerDependencyArrayOutput {
+	return o
+}
+
+func (o ContainerDependencyArrayOutput) Index(i pulumi.IntInput) ContainerDependencyOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ContainerDependency {
+		return vs[0].([]ContainerDependency)[vs[1].(int)]
+	}).(ContainerDependencyOutput)
+}
+
 // Options for setting a custom domain.
 type CustomDomain struct {
 	DomainName     *string `pulumi:"domainName"`
 	HostedZoneName *string `pulumi:"hostedZoneName"`
 }
 
-// CustomDomainInput is an input type that accepts CustomDomainArgs and CustomDomainOutput values.
-// You can construct a concrete instance of `CustomDomainInput` via:
+// CustomDomainInput is an input type that accepts CustomDomainArgs and CustomDomainOutput values.
+// You can construct a concrete instance of `CustomDomainInput` via:
+//
+//	CustomDomainArgs{...}
+type CustomDomainInput interface {
+	pulumi.Input
+
+	ToCustomDomainOutput() CustomDomainOutput
+	ToCustomDomainOutputWithContext(context.Context) CustomDomainOutput
+}
+
+// Options for setting a custom domain.
+type CustomDomainArgs struct {
+	DomainName     pulumi.StringPtrInput `pulumi:"domainName"`
+	HostedZoneName pulumi.StringPtrInput `pulumi:"hostedZoneName"`
+}
+
+func (CustomDomainArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CustomDomain)(nil)).Elem()
+}
+
+func (i CustomDomainArgs) ToCustomDomainOutput() CustomDomainOutput {
+	return i.ToCustomDomainOutputWithContext(context.Background())
+}
+
+func (i CustomDomainArgs) ToCustomDomainOutputWithContext(ctx context.Context) CustomDomainOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CustomDomainOutput)
+}
+
+func (i CustomDomainArgs) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
+	return i.ToCustomDomainPtrOutputWithContext(context.Background())
+}
+
+func (i CustomDomainArgs) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CustomDomainOutput).ToCustomDomainPtrOutputWithContext(ctx)
+}
+
+// CustomDomainPtrInput is an input type that accepts CustomDomainArgs, CustomDomainPtr and CustomDomainPtrOutput values.
+// You can construct a concrete instance of `CustomDomainPtrInput` via:
+//
+//	        CustomDomainArgs{...}
+//
+//	or:
+//
+//	        nil
+type CustomDomainPtrInput interface {
+	pulumi.Input
+
+	ToCustomDomainPtrOutput() CustomDomainPtrOutput
+	ToCustomDomainPtrOutputWithContext(context.Context) CustomDomainPtrOutput
+}
+
+type customDomainPtrType CustomDomainArgs
+
+func CustomDomainPtr(v *CustomDomainArgs) CustomDomainPtrInput {
+	return (*customDomainPtrType)(v)
+}
+
+func (*customDomainPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**CustomDomain)(nil)).Elem()
+}
+
+func (i *customDomainPtrType) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
+	return i.ToCustomDomainPtrOutputWithContext(context.Background())
+}
+
+func (i *customDomainPtrType) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CustomDomainPtrOutput)
+}
+
+// Options for setting a custom domain.
+type CustomDomainOutput struct{ *pulumi.OutputState }
+
+func (CustomDomainOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CustomDomain)(nil)).Elem()
+}
+
+func (o CustomDomainOutput) ToCustomDomainOutput() CustomDomainOutput {
+	return o
+}
+
+func (o CustomDomainOutput) ToCustomDomainOutputWithContext(ctx context.Context) CustomDomainOutput {
+	return o
+}
+
+func (o CustomDomainOutput) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
+	return o.ToCustomDomainPtrOutputWithContext(context.Background())
+}
+
+func (o CustomDomainOutput) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CustomDomain) *CustomDomain {
+		return &v
+	}).(CustomDomainPtrOutput)
+}
+
+func (o CustomDomainOutput) DomainName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CustomDomain) *string { return v.DomainName }).(pulumi.StringPtrOutput)
+}
+
+func (o CustomDomainOutput) HostedZoneName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CustomDomain) *string { return v.HostedZoneName }).(pulumi.StringPtrOutput)
+}
+
+type CustomDomainPtrOutput struct{ *pulumi.OutputState }
+
+func (CustomDomainPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CustomDomain)(nil)).Elem()
+}
+
+func (o CustomDomainPtrOutput) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
+	return o
+}
+
+func (o CustomDomainPtrOutput) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
+	return o
+}
+
+func (o CustomDomainPtrOutput) Elem() CustomDomainOutput {
+	return o.ApplyT(func(v *CustomDomain) CustomDomain {
+		if v != nil {
+			return *v
+		}
+		var ret CustomDomain
+		return ret
+	}).(CustomDomainOutput)
+}
+
+func (o CustomDomainPtrOutput) DomainName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CustomDomain) *string {
+		if v == nil {
+			return nil
+		}
+		return v.DomainName
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CustomDomainPtrOutput) HostedZoneName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CustomDomain) *string {
+		if v == nil {
+			return nil
+		}
+		return v.HostedZoneName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The options for configuring your database.
+type Database struct {
+	// The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+	// file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+	// but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
+	Engine *string `pulumi:"engine"`
+	// The database engine version. Updating this argument results in an outage. See the
+	// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
+	// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
+	// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
+	// where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+	// which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+	// versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+	// later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+	// Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+	// Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+	// `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
+	EngineVersion *string `pulumi:"engineVersion"`
+	// Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+	// scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+	// Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+	// Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+	// Defaults to `false`.
+	UseProxy *bool `pulumi:"useProxy"`
+}
+
+// DatabaseInput is an input type that accepts DatabaseArgs and DatabaseOutput values.
+// You can construct a concrete instance of `DatabaseInput` via:
+//
+//	DatabaseArgs{...}
+type DatabaseInput interface {
+	pulumi.Input
+
+	ToDatabaseOutput() DatabaseOutput
+	ToDatabaseOutputWithContext(context.Context) DatabaseOutput
+}
+
+// The options for configuring your database.
+type DatabaseArgs struct {
+	// The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+	// file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+	// but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
+	Engine *string `pulumi:"engine"`
+	// The database engine version. Updating this argument results in an outage. See the
+	// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
+	// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
+	// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
+	// where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+	// which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+	// versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+	// later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+	// Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+	// Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+	// `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
+	EngineVersion pulumi.StringPtrInput `pulumi:"engineVersion"`
+	// Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+	// scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+	// Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+	// Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+	// Defaults to `false`.
+	UseProxy *bool `pulumi:"useProxy"`
+}
+
+func (DatabaseArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Database)(nil)).Elem()
+}
+
+func (i DatabaseArgs) ToDatabaseOutput() DatabaseOutput {
+	return i.ToDatabaseOutputWithContext(context.Background())
+}
+
+func (i DatabaseArgs) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseOutput)
+}
+
+func (i DatabaseArgs) ToDatabasePtrOutput() DatabasePtrOutput {
+	return i.ToDatabasePtrOutputWithContext(context.Background())
+}
+
+func (i DatabaseArgs) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseOutput).ToDatabasePtrOutputWithContext(ctx)
+}
+
+// DatabasePtrInput is an input type that accepts DatabaseArgs, DatabasePtr and DatabasePtrOutput values.
+// You can construct a concrete instance of `DatabasePtrInput` via:
+//
+//	        DatabaseArgs{...}
+//
+//	or:
+//
+//	        nil
+type DatabasePtrInput interface {
+	pulumi.Input
+
+	ToDatabasePtrOutput() DatabasePtrOutput
+	ToDatabasePtrOutputWithContext(context.Context) DatabasePtrOutput
+}
+
+type databasePtrType DatabaseArgs
+
+func DatabasePtr(v *DatabaseArgs) DatabasePtrInput {
+	return (*databasePtrType)(v)
+}
+
+func (*databasePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Database)(nil)).Elem()
+}
+
+func (i *databasePtrType) ToDatabasePtrOutput() DatabasePtrOutput {
+	return i.ToDatabasePtrOutputWithContext(context.Background())
+}
+
+func (i *databasePtrType) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabasePtrOutput)
+}
+
+// The options for configuring your database.
+type DatabaseOutput struct{ *pulumi.OutputState }
+
+func (DatabaseOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Database)(nil)).Elem()
+}
+
+func (o DatabaseOutput) ToDatabaseOutput() DatabaseOutput {
+	return o
+}
+
+func (o DatabaseOutput) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
+	return o
+}
+
+func (o DatabaseOutput) ToDatabasePtrOutput() DatabasePtrOutput {
+	return o.ToDatabasePtrOutputWithContext(context.Background())
+}
+
+func (o DatabaseOutput) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Database) *Database {
+		return &v
+	}).(DatabasePtrOutput)
+}
+
+// The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+// file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+// but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
+func (o DatabaseOutput) Engine() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Database) *string { return v.Engine }).(pulumi.StringPtrOutput)
+}
+
+// The database engine version. Updating this argument results in an outage. See the
+// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
+// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
+// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
+// where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+// which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+// versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+// later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+// Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+// Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+// `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
+func (o DatabaseOutput) EngineVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Database) *string { return v.EngineVersion }).(pulumi.StringPtrOutput)
+}
+
+// Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+// scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+// Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+// Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+// Defaults to `false`.
+func (o DatabaseOutput) UseProxy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Database) *bool { return v.UseProxy }).(pulumi.BoolPtrOutput)
+}
+
+type DatabasePtrOutput struct{ *pulumi.OutputState }
+
+func (DatabasePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Database)(nil)).Elem()
+}
+
+func (o DatabasePtrOutput) ToDatabasePtrOutput() DatabasePtrOutput {
+	return o
+}
+
+func (o DatabasePtrOutput) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
+	return o
+}
+
+func (o DatabasePtrOutput) Elem() DatabaseOutput {
+	return o.ApplyT(func(v *Database) Database {
+		if v != nil {
+			return *v
+		}
+		var ret Database
+		return ret
+	}).(DatabaseOutput)
+}
+
+// The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+// file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+// but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
+func (o DatabasePtrOutput) Engine() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Database) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Engine
+	}).(pulumi.StringPtrOutput)
+}
+
+// The database engine version. Updating this argument results in an outage. See the
+// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
+// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
+// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
+// where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+// which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+// versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+// later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+// Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+// Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+// `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
+func (o DatabasePtrOutput) EngineVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Database) *string {
+		if v == nil {
+			return nil
+		}
+		return v.EngineVersion
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+// scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+// Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+// Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+// Defaults to `false`.
+func (o DatabasePtrOutput) UseProxy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Database) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.UseProxy
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Options for ECS Exec sessions into the Metabase container.
+type ExecuteCommand struct {
+	// The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+	// is allowed to decrypt with the key.
+	KmsKeyId *string `pulumi:"kmsKeyId"`
+	// The name of a CloudWatch log group to log the session output to.
+	LogGroupName *string `pulumi:"logGroupName"`
+	// The name of an S3 bucket to log the session output to.
+	S3BucketName *string `pulumi:"s3BucketName"`
+	// The key prefix of the session logs in `s3BucketName`.
+	S3KeyPrefix *string `pulumi:"s3KeyPrefix"`
+}
+
+// ExecuteCommandInput is an input type that accepts ExecuteCommandArgs and ExecuteCommandOutput values.
+// You can construct a concrete instance of `ExecuteCommandInput` via:
+//
+//	ExecuteCommandArgs{...}
+type ExecuteCommandInput interface {
+	pulumi.Input
+
+	ToExecuteCommandOutput() ExecuteCommandOutput
+	ToExecuteCommandOutputWithContext(context.Context) ExecuteCommandOutput
+}
+
+// Options for ECS Exec sessions into the Metabase container.
+type ExecuteCommandArgs struct {
+	// The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+	// is allowed to decrypt with the key.
+	KmsKeyId pulumi.StringPtrInput `pulumi:"kmsKeyId"`
+	// The name of a CloudWatch log group to log the session output to.
+	LogGroupName pulumi.StringPtrInput `pulumi:"logGroupName"`
+	// The name of an S3 bucket to log the session output to.
+	S3BucketName pulumi.StringPtrInput `pulumi:"s3BucketName"`
+	// The key prefix of the session logs in `s3BucketName`.
+	S3KeyPrefix pulumi.StringPtrInput `pulumi:"s3KeyPrefix"`
+}
+
+func (ExecuteCommandArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExecuteCommand)(nil)).Elem()
+}
+
+func (i ExecuteCommandArgs) ToExecuteCommandOutput() ExecuteCommandOutput {
+	return i.ToExecuteCommandOutputWithContext(context.Background())
+}
+
+func (i ExecuteCommandArgs) ToExecuteCommandOutputWithContext(ctx context.Context) ExecuteCommandOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExecuteCommandOutput)
+}
+
+func (i ExecuteCommandArgs) ToExecuteCommandPtrOutput() ExecuteCommandPtrOutput {
+	return i.ToExecuteCommandPtrOutputWithContext(context.Background())
+}
+
+func (i ExecuteCommandArgs) ToExecuteCommandPtrOutputWithContext(ctx context.Context) ExecuteCommandPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExecuteCommandOutput).ToExecuteCommandPtrOutputWithContext(ctx)
+}
+
+// ExecuteCommandPtrInput is an input type that accepts ExecuteCommandArgs, ExecuteCommandPtr and ExecuteCommandPtrOutput values.
+// You can construct a concrete instance of `ExecuteCommandPtrInput` via:
+//
+//	        ExecuteCommandArgs{...}
+//
+//	or:
+//
+//	        nil
+type ExecuteCommandPtrInput interface {
+	pulumi.Input
+
+	ToExecuteCommandPtrOutput() ExecuteCommandPtrOutput
+	ToExecuteCommandPtrOutputWithContext(context.Context) ExecuteCommandPtrOutput
+}
+
+type executeCommandPtrType ExecuteCommandArgs
+
+func ExecuteCommandPtr(v *ExecuteCommandArgs) ExecuteCommandPtrInput {
+	return (*executeCommandPtrType)(v)
+}
+
+func (*executeCommandPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ExecuteCommand)(nil)).Elem()
+}
+
+func (i *executeCommandPtrType) ToExecuteCommandPtrOutput() ExecuteCommandPtrOutput {
+	return i.ToExecuteCommandPtrOutputWithContext(context.Background())
+}
+
+func (i *executeCommandPtrType) ToExecuteCommandPtrOutputWithContext(ctx context.Context) ExecuteCommandPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExecuteCommandPtrOutput)
+}
+
+// Options for ECS Exec sessions into the Metabase container.
+type ExecuteCommandOutput struct{ *pulumi.OutputState }
+
+func (ExecuteCommandOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExecuteCommand)(nil)).Elem()
+}
+
+func (o ExecuteCommandOutput) ToExecuteCommandOutput() ExecuteCommandOutput {
+	return o
+}
+
+func (o ExecuteCommandOutput) ToExecuteCommandOutputWithContext(ctx context.Context) ExecuteCommandOutput {
+	return o
+}
+
+func (o ExecuteCommandOutput) ToExecuteCommandPtrOutput() ExecuteCommandPtrOutput {
+	return o.ToExecuteCommandPtrOutputWithContext(context.Background())
+}
+
+func (o ExecuteCommandOutput) ToExecuteCommandPtrOutputWithContext(ctx context.Context) ExecuteCommandPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ExecuteCommand) *ExecuteCommand {
+		return &v
+	}).(ExecuteCommandPtrOutput)
+}
+
+// The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+// is allowed to decrypt with the key.
+func (o ExecuteCommandOutput) KmsKeyId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ExecuteCommand) *string { return v.KmsKeyId }).(pulumi.StringPtrOutput)
+}
+
+// The name of a CloudWatch log group to log the session output to.
+func (o ExecuteCommandOutput) LogGroupName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ExecuteCommand) *string { return v.LogGroupName }).(pulumi.StringPtrOutput)
+}
+
+// The name of an S3 bucket to log the session output to.
+func (o ExecuteCommandOutput) S3BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ExecuteCommand) *string { return v.S3BucketName }).(pulumi.StringPtrOutput)
+}
+
+// The key prefix of the session logs in `s3BucketName`.
+func (o ExecuteCommandOutput) S3KeyPrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ExecuteCommand) *string { return v.S3KeyPrefix }).(pulumi.StringPtrOutput)
+}
+
+type ExecuteCommandPtrOutput struct{ *pulumi.OutputState }
+
+func (ExecuteCommandPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ExecuteCommand)(nil)).Elem()
+}
+
+func (o ExecuteCommandPtrOutput) ToExecuteCommandPtrOutput() ExecuteCommandPtrOutput {
+	return o
+}
+
+func (o ExecuteCommandPtrOutput) ToExecuteCommandPtrOutputWithContext(ctx context.Context) ExecuteCommandPtrOutput {
+	return o
+}
+
+func (o ExecuteCommandPtrOutput) Elem() ExecuteCommandOutput {
+	return o.ApplyT(func(v *ExecuteCommand) ExecuteCommand {
+		if v != nil {
+			return *v
+		}
+		var ret ExecuteCommand
+		return ret
+	}).(ExecuteCommandOutput)
+}
+
+// The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+// is allowed to decrypt with the key.
+func (o ExecuteCommandPtrOutput) KmsKeyId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ExecuteCommand) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KmsKeyId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The name of a CloudWatch log group to log the session output to.
+func (o ExecuteCommandPtrOutput) LogGroupName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ExecuteCommand) *string {
+		if v == nil {
+			return nil
+		}
+		return v.LogGroupName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The name of an S3 bucket to log the session output to.
+func (o ExecuteCommandPtrOutput) S3BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ExecuteCommand) *string {
+		if v == nil {
+			return nil
+		}
+		return v.S3BucketName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The key prefix of the session logs in `s3BucketName`.
+func (o ExecuteCommandPtrOutput) S3KeyPrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ExecuteCommand) *string {
+		if v == nil {
+			return nil
+		}
+		return v.S3KeyPrefix
+	}).(pulumi.StringPtrOutput)
+}
+
+// Options for routing the Metabase container logs with AWS FireLens.
+type FireLens struct {
+	// The Fluent Bit image to run as the log router.
+	Image *string `pulumi:"image"`
+	// The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+	// `Name` of the output plugin is required.
+	Options map[string]string `pulumi:"options"`
+	// Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+	// of setting name to secret ARN. The task execution role is granted access to these secrets.
+	SecretOptions map[string]string `pulumi:"secretOptions"`
+}
+
+// Defaults sets the appropriate defaults for FireLens
+func (val *FireLens) Defaults() *FireLens {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if isZero(tmp.Image) {
+		image_ := "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"
+		tmp.Image = &image_
+	}
+	return &tmp
+}
+
+// FireLensInput is an input type that accepts FireLensArgs and FireLensOutput values.
+// You can construct a concrete instance of `FireLensInput` via:
+//
+//	FireLensArgs{...}
+type FireLensInput interface {
+	pulumi.Input
+
+	ToFireLensOutput() FireLensOutput
+	ToFireLensOutputWithContext(context.Context) FireLensOutput
+}
+
+// Options for routing the Metabase container logs with AWS FireLens.
+type FireLensArgs struct {
+	// The Fluent Bit image to run as the log router.
+	Image pulumi.StringPtrInput `pulumi:"image"`
+	// The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+	// `Name` of the output plugin is required.
+	Options pulumi.StringMapInput `pulumi:"options"`
+	// Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+	// of setting name to secret ARN. The task execution role is granted access to these secrets.
+	SecretOptions pulumi.StringMapInput `pulumi:"secretOptions"`
+}
+
+func (FireLensArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FireLens)(nil)).Elem()
+}
+
+func (i FireLensArgs) ToFireLensOutput() FireLensOutput {
+	return i.ToFireLensOutputWithContext(context.Background())
+}
+
+func (i FireLensArgs) ToFireLensOutputWithContext(ctx context.Context) FireLensOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FireLensOutput)
+}
+
+func (i FireLensArgs) ToFireLensPtrOutput() FireLensPtrOutput {
+	return i.ToFireLensPtrOutputWithContext(context.Background())
+}
+
+func (i FireLensArgs) ToFireLensPtrOutputWithContext(ctx context.Context) FireLensPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FireLensOutput).ToFireLensPtrOutputWithContext(ctx)
+}
+
+// FireLensPtrInput is an input type that accepts FireLensArgs, FireLensPtr and FireLensPtrOutput values.
+// You can construct a concrete instance of `FireLensPtrInput` via:
+//
+//	        FireLensArgs{...}
+//
+//	or:
+//
+//	        nil
+type FireLensPtrInput interface {
+	pulumi.Input
+
+	ToFireLensPtrOutput() FireLensPtrOutput
+	ToFireLensPtrOutputWithContext(context.Context) FireLensPtrOutput
+}
+
+type fireLensPtrType FireLensArgs
+
+func FireLensPtr(v *FireLensArgs) FireLensPtrInput {
+	return (*fireLensPtrType)(v)
+}
+
+func (*fireLensPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FireLens)(nil)).Elem()
+}
+
+func (i *fireLensPtrType) ToFireLensPtrOutput() FireLensPtrOutput {
+	return i.ToFireLensPtrOutputWithContext(context.Background())
+}
+
+func (i *fireLensPtrType) ToFireLensPtrOutputWithContext(ctx context.Context) FireLensPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FireLensPtrOutput)
+}
+
+// Options for routing the Metabase container logs with AWS FireLens.
+type FireLensOutput struct{ *pulumi.OutputState }
+
+func (FireLensOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FireLens)(nil)).Elem()
+}
+
+func (o FireLensOutput) ToFireLensOutput() FireLensOutput {
+	return o
+}
+
+func (o FireLensOutput) ToFireLensOutputWithContext(ctx context.Context) FireLensOutput {
+	return o
+}
+
+func (o FireLensOutput) ToFireLensPtrOutput() FireLensPtrOutput {
+	return o.ToFireLensPtrOutputWithContext(context.Background())
+}
+
+func (o FireLensOutput) ToFireLensPtrOutputWithContext(ctx context.Context) FireLensPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FireLens) *FireLens {
+		return &v
+	}).(FireLensPtrOutput)
+}
+
+// The Fluent Bit image to run as the log router.
+func (o FireLensOutput) Image() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FireLens) *string { return v.Image }).(pulumi.StringPtrOutput)
+}
+
+// The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+// `Name` of the output plugin is required.
+func (o FireLensOutput) Options() pulumi.StringMapOutput {
+	return o.ApplyT(func(v FireLens) map[string]string { return v.Options }).(pulumi.StringMapOutput)
+}
+
+// Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+// of setting name to secret ARN. The task execution role is granted access to these secrets.
+func (o FireLensOutput) SecretOptions() pulumi.StringMapOutput {
+	return o.ApplyT(func(v FireLens) map[string]string { return v.SecretOptions }).(pulumi.StringMapOutput)
+}
+
+type FireLensPtrOutput struct{ *pulumi.OutputState }
+
+func (FireLensPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FireLens)(nil)).Elem()
+}
+
+func (o FireLensPtrOutput) ToFireLensPtrOutput() FireLensPtrOutput {
+	return o
+}
+
+func (o FireLensPtrOutput) ToFireLensPtrOutputWithContext(ctx context.Context) FireLensPtrOutput {
+	return o
+}
+
+func (o FireLensPtrOutput) Elem() FireLensOutput {
+	return o.ApplyT(func(v *FireLens) FireLens {
+		if v != nil {
+			return *v
+		}
+		var ret FireLens
+		return ret
+	}).(FireLensOutput)
+}
+
+// The Fluent Bit image to run as the log router.
+func (o FireLensPtrOutput) Image() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FireLens) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Image
+	}).(pulumi.StringPtrOutput)
+}
+
+// The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+// `Name` of the output plugin is required.
+func (o FireLensPtrOutput) Options() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *FireLens) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Options
+	}).(pulumi.StringMapOutput)
+}
+
+// Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+// of setting name to secret ARN. The task execution role is granted access to these secrets.
+func (o FireLensPtrOutput) SecretOptions() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *FireLens) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.SecretOptions
+	}).(pulumi.StringMapOutput)
+}
+
+// Options for the health checks run by the load balancer target group and the ECS container
+// against Metabase's `/api/health` endpoint. The container isn't health checked when running a custom `image`.
+type HealthCheck struct {
+	// The number of consecutive successful health checks required before a task is considered healthy.
+	HealthyThreshold *int `pulumi:"healthyThreshold"`
+	// The approximate number of seconds between health checks. Must be between 5 and 300.
+	Interval *int `pulumi:"interval"`
+	// The number of seconds to give Metabase to start and run its database migrations before failed
+	// health checks count against it. Must be between 0 and 300.
+	StartPeriod *int `pulumi:"startPeriod"`
+	// The number of seconds to wait for a response before failing a health check. Must be between
+	// 2 and 60 and less than `interval`.
+	Timeout *int `pulumi:"timeout"`
+	// The number of consecutive failed health checks required before a task is considered unhealthy.
+	UnhealthyThreshold *int `pulumi:"unhealthyThreshold"`
+}
+
+// Defaults sets the appropriate defaults for HealthCheck
+func (val *HealthCheck) Defaults() *HealthCheck {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if isZero(tmp.HealthyThreshold) {
+		healthyThreshold_ := 2
+		tmp.HealthyThreshold = &healthyThreshold_
+	}
+	if isZero(tmp.Interval) {
+		interval_ := 30
+		tmp.Interval = &interval_
+	}
+	if isZero(tmp.StartPeriod) {
+		startPeriod_ := 300
+		tmp.StartPeriod = &startPeriod_
+	}
+	if isZero(tmp.Timeout) {
+		timeout_ := 5
+		tmp.Timeout = &timeout_
+	}
+	if isZero(tmp.UnhealthyThreshold) {
+		unhealthyThreshold_ := 5
+		tmp.UnhealthyThreshold = &unhealthyThreshold_
+	}
+	return &tmp
+}
+
+// HealthCheckInput is an input type that accepts HealthCheckArgs and HealthCheckOutput values.
+// You can construct a concrete instance of `HealthCheckInput` via:
+//
+//	HealthCheckArgs{...}
+type HealthCheckInput interface {
+	pulumi.Input
+
+	ToHealthCheckOutput() HealthCheckOutput
+	ToHealthCheckOutputWithContext(context.Context) HealthCheckOutput
+}
+
+// Options for the health checks run by the load balancer target group and the ECS container
+// against Metabase's `/api/health` endpoint. The container isn't health checked when running a custom `image`.
+type HealthCheckArgs struct {
+	// The number of consecutive successful health checks required before a task is considered healthy.
+	HealthyThreshold *int `pulumi:"healthyThreshold"`
+	// The approximate number of seconds between health checks. Must be between 5 and 300.
+	Interval *int `pulumi:"interval"`
+	// The number of seconds to give Metabase to start and run its database migrations before failed
+	// health checks count against it. Must be between 0 and 300.
+	StartPeriod *int `pulumi:"startPeriod"`
+	// The number of seconds to wait for a response before failing a health check. Must be between
+	// 2 and 60 and less than `interval`.
+	Timeout *int `pulumi:"timeout"`
+	// The number of consecutive failed health checks required before a task is considered unhealthy.
+	UnhealthyThreshold *int `pulumi:"unhealthyThreshold"`
+}
+
+func (HealthCheckArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*HealthCheck)(nil)).Elem()
+}
+
+func (i HealthCheckArgs) ToHealthCheckOutput() HealthCheckOutput {
+	return i.ToHealthCheckOutputWithContext(context.Background())
+}
+
+func (i HealthCheckArgs) ToHealthCheckOutputWithContext(ctx context.Context) HealthCheckOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HealthCheckOutput)
+}
+
+func (i HealthCheckArgs) ToHealthCheckPtrOutput() HealthCheckPtrOutput {
+	return i.ToHealthCheckPtrOutputWithContext(context.Background())
+}
+
+func (i HealthCheckArgs) ToHealthCheckPtrOutputWithContext(ctx context.Context) HealthCheckPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HealthCheckOutput).ToHealthCheckPtrOutputWithContext(ctx)
+}
+
+// HealthCheckPtrInput is an input type that accepts HealthCheckArgs, HealthCheckPtr and HealthCheckPtrOutput values.
+// You can construct a concrete instance of `HealthCheckPtrInput` via:
+//
+//	        HealthCheckArgs{...}
+//
+//	or:
+//
+//	        nil
+type HealthCheckPtrInput interface {
+	pulumi.Input
+
+	ToHealthCheckPtrOutput() HealthCheckPtrOutput
+	ToHealthCheckPtrOutputWithContext(context.Context) HealthCheckPtrOutput
+}
+
+type healthCheckPtrType HealthCheckArgs
+
+func HealthCheckPtr(v *HealthCheckArgs) HealthCheckPtrInput {
+	return (*healthCheckPtrType)(v)
+}
+
+func (*healthCheckPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**HealthCheck)(nil)).Elem()
+}
+
+func (i *healthCheckPtrType) ToHealthCheckPtrOutput() HealthCheckPtrOutput {
+	return i.ToHealthCheckPtrOutputWithContext(context.Background())
+}
+
+func (i *healthCheckPtrType) ToHealthCheckPtrOutputWithContext(ctx context.Context) HealthCheckPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HealthCheckPtrOutput)
+}
+
+// Options for the health checks run by the load balancer target group and the ECS container
+// against Metabase's `/api/health` endpoint. The container isn't health checked when running a custom `image`.
+type HealthCheckOutput struct{ *pulumi.OutputState }
+
+func (HealthCheckOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*HealthCheck)(nil)).Elem()
+}
+
+func (o HealthCheckOutput) ToHealthCheckOutput() HealthCheckOutput {
+	return o
+}
+
+func (o HealthCheckOutput) ToHealthCheckOutputWithContext(ctx context.Context) HealthCheckOutput {
+	return o
+}
+
+func (o HealthCheckOutput) ToHealthCheckPtrOutput() HealthCheckPtrOutput {
+	return o.ToHealthCheckPtrOutputWithContext(context.Background())
+}
+
+func (o HealthCheckOutput) ToHealthCheckPtrOutputWithContext(ctx context.Context) HealthCheckPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v HealthCheck) *HealthCheck {
+		return &v
+	}).(HealthCheckPtrOutput)
+}
+
+// The number of consecutive successful health checks required before a task is considered healthy.
+func (o HealthCheckOutput) HealthyThreshold() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v HealthCheck) *int { return v.HealthyThreshold }).(pulumi.IntPtrOutput)
+}
+
+// The approximate number of seconds between health checks. Must be between 5 and 300.
+func (o HealthCheckOutput) Interval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v HealthCheck) *int { return v.Interval }).(pulumi.IntPtrOutput)
+}
+
+// The number of seconds to give Metabase to start and run its database migrations before failed
+// health checks count against it. Must be between 0 and 300.
+func (o HealthCheckOutput) StartPeriod() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v HealthCheck) *int { return v.StartPeriod }).(pulumi.IntPtrOutput)
+}
+
+// The number of seconds to wait for a response before failing a health check. Must be between
+// 2 and 60 and less than `interval`.
+func (o HealthCheckOutput) Timeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v HealthCheck) *int { return v.Timeout }).(pulumi.IntPtrOutput)
+}
+
+// The number of consecutive failed health checks required before a task is considered unhealthy.
+func (o HealthCheckOutput) UnhealthyThreshold() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v HealthCheck) *int { return v.UnhealthyThreshold }).(pulumi.IntPtrOutput)
+}
+
+type HealthCheckPtrOutput struct{ *pulumi.OutputState }
+
+func (HealthCheckPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**HealthCheck)(nil)).Elem()
+}
+
+func (o HealthCheckPtrOutput) ToHealthCheckPtrOutput() HealthCheckPtrOutput {
+	return o
+}
+
+func (o HealthCheckPtrOutput) ToHealthCheckPtrOutputWithContext(ctx context.Context) HealthCheckPtrOutput {
+	return o
+}
+
+func (o HealthCheckPtrOutput) Elem() HealthCheckOutput {
+	return o.ApplyT(func(v *HealthCheck) HealthCheck {
+		if v != nil {
+			return *v
+		}
+		var ret HealthCheck
+		return ret
+	}).(HealthCheckOutput)
+}
+
+// The number of consecutive successful health checks required before a task is considered healthy.
+func (o HealthCheckPtrOutput) HealthyThreshold() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *HealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.HealthyThreshold
+	}).(pulumi.IntPtrOutput)
+}
+
+// The approximate number of seconds between health checks. Must be between 5 and 300.
+func (o HealthCheckPtrOutput) Interval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *HealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Interval
+	}).(pulumi.IntPtrOutput)
+}
+
+// The number of seconds to give Metabase to start and run its database migrations before failed
+// health checks count against it. Must be between 0 and 300.
+func (o HealthCheckPtrOutput) StartPeriod() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *HealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.StartPeriod
+	}).(pulumi.IntPtrOutput)
+}
+
+// The number of seconds to wait for a response before failing a health check. Must be between
+// 2 and 60 and less than `interval`.
+func (o HealthCheckPtrOutput) Timeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *HealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Timeout
+	}).(pulumi.IntPtrOutput)
+}
+
+// The number of consecutive failed health checks required before a task is considered unhealthy.
+func (o HealthCheckPtrOutput) UnhealthyThreshold() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *HealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.UnhealthyThreshold
+	}).(pulumi.IntPtrOutput)
+}
+
+// Options for serving the Metabase image from a private ECR registry instead of Docker Hub.
+type ImageMirror struct {
+	// Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+	ScanOnPush *bool `pulumi:"scanOnPush"`
+	// How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+	// with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+	// it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+	// stack, which deletes its images when the mirror is removed.
+	Type *string `pulumi:"type"`
+}
+
+// Defaults sets the appropriate defaults for ImageMirror
+func (val *ImageMirror) Defaults() *ImageMirror {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if isZero(tmp.ScanOnPush) {
+		scanOnPush_ := true
+		tmp.ScanOnPush = &scanOnPush_
+	}
+	return &tmp
+}
+
+// ImageMirrorInput is an input type that accepts ImageMirrorArgs and ImageMirrorOutput values.
+// You can construct a concrete instance of `ImageMirrorInput` via:
+//
+//	ImageMirrorArgs{...}
+type ImageMirrorInput interface {
+	pulumi.Input
+
+	ToImageMirrorOutput() ImageMirrorOutput
+	ToImageMirrorOutputWithContext(context.Context) ImageMirrorOutput
+}
+
+// Options for serving the Metabase image from a private ECR registry instead of Docker Hub.
+type ImageMirrorArgs struct {
+	// Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+	ScanOnPush *bool `pulumi:"scanOnPush"`
+	// How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+	// with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+	// it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+	// stack, which deletes its images when the mirror is removed.
+	Type *string `pulumi:"type"`
+}
+
+func (ImageMirrorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ImageMirror)(nil)).Elem()
+}
+
+func (i ImageMirrorArgs) ToImageMirrorOutput() ImageMirrorOutput {
+	return i.ToImageMirrorOutputWithContext(context.Background())
+}
+
+func (i ImageMirrorArgs) ToImageMirrorOutputWithContext(ctx context.Context) ImageMirrorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ImageMirrorOutput)
+}
+
+func (i ImageMirrorArgs) ToImageMirrorPtrOutput() ImageMirrorPtrOutput {
+	return i.ToImageMirrorPtrOutputWithContext(context.Background())
+}
+
+func (i ImageMirrorArgs) ToImageMirrorPtrOutputWithContext(ctx context.Context) ImageMirrorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ImageMirrorOutput).ToImageMirrorPtrOutputWithContext(ctx)
+}
+
+// ImageMirrorPtrInput is an input type that accepts ImageMirrorArgs, ImageMirrorPtr and ImageMirrorPtrOutput values.
+// You can construct a concrete instance of `ImageMirrorPtrInput` via:
+//
+//	        ImageMirrorArgs{...}
+//
+//	or:
+//
+//	        nil
+type ImageMirrorPtrInput interface {
+	pulumi.Input
+
+	ToImageMirrorPtrOutput() ImageMirrorPtrOutput
+	ToImageMirrorPtrOutputWithContext(context.Context) ImageMirrorPtrOutput
+}
+
+type imageMirrorPtrType ImageMirrorArgs
+
+func ImageMirrorPtr(v *ImageMirrorArgs) ImageMirrorPtrInput {
+	return (*imageMirrorPtrType)(v)
+}
+
+func (*imageMirrorPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ImageMirror)(nil)).Elem()
+}
+
+func (i *imageMirrorPtrType) ToImageMirrorPtrOutput() ImageMirrorPtrOutput {
+	return i.ToImageMirrorPtrOutputWithContext(context.Background())
+}
+
+func (i *imageMirrorPtrType) ToImageMirrorPtrOutputWithContext(ctx context.Context) ImageMirrorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ImageMirrorPtrOutput)
+}
+
+// Options for serving the Metabase image from a private ECR registry instead of Docker Hub.
+type ImageMirrorOutput struct{ *pulumi.OutputState }
+
+func (ImageMirrorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ImageMirror)(nil)).Elem()
+}
+
+func (o ImageMirrorOutput) ToImageMirrorOutput() ImageMirrorOutput {
+	return o
+}
+
+func (o ImageMirrorOutput) ToImageMirrorOutputWithContext(ctx context.Context) ImageMirrorOutput {
+	return o
+}
+
+func (o ImageMirrorOutput) ToImageMirrorPtrOutput() ImageMirrorPtrOutput {
+	return o.ToImageMirrorPtrOutputWithContext(context.Background())
+}
+
+func (o ImageMirrorOutput) ToImageMirrorPtrOutputWithContext(ctx context.Context) ImageMirrorPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ImageMirror) *ImageMirror {
+		return &v
+	}).(ImageMirrorPtrOutput)
+}
+
+// Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+func (o ImageMirrorOutput) ScanOnPush() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ImageMirror) *bool { return v.ScanOnPush }).(pulumi.BoolPtrOutput)
+}
+
+// How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+// with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+// it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+// stack, which deletes its images when the mirror is removed.
+func (o ImageMirrorOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ImageMirror) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type ImageMirrorPtrOutput struct{ *pulumi.OutputState }
+
+func (ImageMirrorPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ImageMirror)(nil)).Elem()
+}
+
+func (o ImageMirrorPtrOutput) ToImageMirrorPtrOutput() ImageMirrorPtrOutput {
+	return o
+}
+
+func (o ImageMirrorPtrOutput) ToImageMirrorPtrOutputWithContext(ctx context.Context) ImageMirrorPtrOutput {
+	return o
+}
+
+func (o ImageMirrorPtrOutput) Elem() ImageMirrorOutput {
+	return o.ApplyT(func(v *ImageMirror) ImageMirror {
+		if v != nil {
+			return *v
+		}
+		var ret ImageMirror
+		return ret
+	}).(ImageMirrorOutput)
+}
+
+// Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+func (o ImageMirrorPtrOutput) ScanOnPush() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ImageMirror) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ScanOnPush
+	}).(pulumi.BoolPtrOutput)
+}
+
+// How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+// with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+// it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+// stack, which deletes its images when the mirror is removed.
+func (o ImageMirrorPtrOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ImageMirror) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Type
+	}).(pulumi.StringPtrOutput)
+}
+
+// Options for shipping the Metabase container logs to CloudWatch Logs.
+type Logging struct {
+	// Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+	// instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+	Firelens *FireLens `pulumi:"firelens"`
+	// The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+	// service principal to use the key.
+	KmsKeyId *string `pulumi:"kmsKeyId"`
+	// The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+	// 150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+	RetentionInDays *int `pulumi:"retentionInDays"`
+}
+
+// Defaults sets the appropriate defaults for Logging
+func (val *Logging) Defaults() *Logging {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	tmp.Firelens = tmp.Firelens.Defaults()
+
+	if isZero(tmp.RetentionInDays) {
+		retentionInDays_ := 30
+		tmp.RetentionInDays = &retentionInDays_
+	}
+	return &tmp
+}
+
+// LoggingInput is an input type that accepts LoggingArgs and LoggingOutput values.
+// You can construct a concrete instance of `LoggingInput` via:
+//
+//	LoggingArgs{...}
+type LoggingInput interface {
+	pulumi.Input
+
+	ToLoggingOutput() LoggingOutput
+	ToLoggingOutputWithContext(context.Context) LoggingOutput
+}
+
+// Options for shipping the Metabase container logs to CloudWatch Logs.
+type LoggingArgs struct {
+	// Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+	// instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+	Firelens FireLensPtrInput `pulumi:"firelens"`
+	// The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+	// service principal to use the key.
+	KmsKeyId pulumi.StringPtrInput `pulumi:"kmsKeyId"`
+	// The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+	// 150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+	RetentionInDays *int `pulumi:"retentionInDays"`
+}
+
+func (LoggingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Logging)(nil)).Elem()
+}
+
+func (i LoggingArgs) ToLoggingOutput() LoggingOutput {
+	return i.ToLoggingOutputWithContext(context.Background())
+}
+
+func (i LoggingArgs) ToLoggingOutputWithContext(ctx context.Context) LoggingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LoggingOutput)
+}
+
+func (i LoggingArgs) ToLoggingPtrOutput() LoggingPtrOutput {
+	return i.ToLoggingPtrOutputWithContext(context.Background())
+}
+
+func (i LoggingArgs) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LoggingOutput).ToLoggingPtrOutputWithContext(ctx)
+}
+
+// LoggingPtrInput is an input type that accepts LoggingArgs, LoggingPtr and LoggingPtrOutput values.
+// You can construct a concrete instance of `LoggingPtrInput` via:
+//
+//	        LoggingArgs{...}
+//
+//	or:
+//
+//	        nil
+type LoggingPtrInput interface {
+	pulumi.Input
+
+	ToLoggingPtrOutput() LoggingPtrOutput
+	ToLoggingPtrOutputWithContext(context.Context) LoggingPtrOutput
+}
+
+type loggingPtrType LoggingArgs
+
+func LoggingPtr(v *LoggingArgs) LoggingPtrInput {
+	return (*loggingPtrType)(v)
+}
+
+func (*loggingPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Logging)(nil)).Elem()
+}
+
+func (i *loggingPtrType) ToLoggingPtrOutput() LoggingPtrOutput {
+	return i.ToLoggingPtrOutputWithContext(context.Background())
+}
+
+func (i *loggingPtrType) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LoggingPtrOutput)
+}
+
+// Options for shipping the Metabase container logs to CloudWatch Logs.
+type LoggingOutput struct{ *pulumi.OutputState }
+
+func (LoggingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Logging)(nil)).Elem()
+}
+
+func (o LoggingOutput) ToLoggingOutput() LoggingOutput {
+	return o
+}
+
+func (o LoggingOutput) ToLoggingOutputWithContext(ctx context.Context) LoggingOutput {
+	return o
+}
+
+func (o LoggingOutput) ToLoggingPtrOutput() LoggingPtrOutput {
+	return o.ToLoggingPtrOutputWithContext(context.Background())
+}
+
+func (o LoggingOutput) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Logging) *Logging {
+		return &v
+	}).(LoggingPtrOutput)
+}
+
+// Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+// instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+func (o LoggingOutput) Firelens() FireLensPtrOutput {
+	return o.ApplyT(func(v Logging) *FireLens { return v.Firelens }).(FireLensPtrOutput)
+}
+
+// The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+// service principal to use the key.
+func (o LoggingOutput) KmsKeyId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Logging) *string { return v.KmsKeyId }).(pulumi.StringPtrOutput)
+}
+
+// The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+// 150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+func (o LoggingOutput) RetentionInDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Logging) *int { return v.RetentionInDays }).(pulumi.IntPtrOutput)
+}
+
+type LoggingPtrOutput struct{ *pulumi.OutputState }
+
+func (LoggingPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Logging)(nil)).Elem()
+}
+
+func (o LoggingPtrOutput) ToLoggingPtrOutput() LoggingPtrOutput {
+	return o
+}
+
+func (o LoggingPtrOutput) ToLoggingPtrOutputWithContext(ctx context.Context) LoggingPtrOutput {
+	return o
+}
+
+func (o LoggingPtrOutput) Elem() LoggingOutput {
+	return o.ApplyT(func(v *Logging) Logging {
+		if v != nil {
+			return *v
+		}
+		var ret Logging
+		return ret
+	}).(LoggingOutput)
+}
+
+// Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+// instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+func (o LoggingPtrOutput) Firelens() FireLensPtrOutput {
+	return o.ApplyT(func(v *Logging) *FireLens {
+		if v == nil {
+			return nil
+		}
+		return v.Firelens
+	}).(FireLensPtrOutput)
+}
+
+// The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+// service principal to use the key.
+func (o LoggingPtrOutput) KmsKeyId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Logging) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KmsKeyId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+// 150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+func (o LoggingPtrOutput) RetentionInDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Logging) *int {
+		if v == nil {
+			return nil
+		}
+		return v.RetentionInDays
+	}).(pulumi.IntPtrOutput)
+}
+
+// The options for networking.
+type Networking struct {
+	// The subnets to use for the RDS instance.
+	DbSubnetIds []string `pulumi:"dbSubnetIds"`
+	// The subnets to use for the Fargate task.
+	EcsSubnetIds []string `pulumi:"ecsSubnetIds"`
+	// The subnets to use for the load balancer.
+	LbSubnetIds []string `pulumi:"lbSubnetIds"`
+}
+
+// NetworkingInput is an input type that accepts NetworkingArgs and NetworkingOutput values.
+// You can construct a concrete instance of `NetworkingInput` via:
+//
+//	NetworkingArgs{...}
+type NetworkingInput interface {
+	pulumi.Input
+
+	ToNetworkingOutput() NetworkingOutput
+	ToNetworkingOutputWithContext(context.Context) NetworkingOutput
+}
+
+// The options for networking.
+type NetworkingArgs struct {
+	// The subnets to use for the RDS instance.
+	DbSubnetIds pulumi.StringArrayInput `pulumi:"dbSubnetIds"`
+	// The subnets to use for the Fargate task.
+	EcsSubnetIds pulumi.StringArrayInput `pulumi:"ecsSubnetIds"`
+	// The subnets to use for the load balancer.
+	LbSubnetIds pulumi.StringArrayInput `pulumi:"lbSubnetIds"`
+}
+
+func (NetworkingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Networking)(nil)).Elem()
+}
+
+func (i NetworkingArgs) ToNetworkingOutput() NetworkingOutput {
+	return i.ToNetworkingOutputWithContext(context.Background())
+}
+
+func (i NetworkingArgs) ToNetworkingOutputWithContext(ctx context.Context) NetworkingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkingOutput)
+}
+
+func (i NetworkingArgs) ToNetworkingPtrOutput() NetworkingPtrOutput {
+	return i.ToNetworkingPtrOutputWithContext(context.Background())
+}
+
+func (i NetworkingArgs) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkingOutput).ToNetworkingPtrOutputWithContext(ctx)
+}
+
+// NetworkingPtrInput is an input type that accepts NetworkingArgs, NetworkingPtr and NetworkingPtrOutput values.
+// You can construct a concrete instance of `NetworkingPtrInput` via:
+//
+//	        NetworkingArgs{...}
+//
+//	or:
+//
+//	        nil
+type NetworkingPtrInput interface {
+	pulumi.Input
+
+	ToNetworkingPtrOutput() NetworkingPtrOutput
+	ToNetworkingPtrOutputWithContext(context.Context) NetworkingPtrOutput
+}
+
+type networkingPtrType NetworkingArgs
+
+func NetworkingPtr(v *NetworkingArgs) NetworkingPtrInput {
+	return (*networkingPtrType)(v)
+}
+
+func (*networkingPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Networking)(nil)).Elem()
+}
+
+func (i *networkingPtrType) ToNetworkingPtrOutput() NetworkingPtrOutput {
+	return i.ToNetworkingPtrOutputWithContext(context.Background())
+}
+
+func (i *networkingPtrType) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkingPtrOutput)
+}
+
+// The options for networking.
+type NetworkingOutput struct{ *pulumi.OutputState }
+
+func (NetworkingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Networking)(nil)).Elem()
+}
+
+func (o NetworkingOutput) ToNetworkingOutput() NetworkingOutput {
+	return o
+}
+
+func (o NetworkingOutput) ToNetworkingOutputWithContext(ctx context.Context) NetworkingOutput {
+	return o
+}
+
+func (o NetworkingOutput) ToNetworkingPtrOutput() NetworkingPtrOutput {
+	return o.ToNetworkingPtrOutputWithContext(context.Background())
+}
+
+func (o NetworkingOutput) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Networking) *Networking {
+		return &v
+	}).(NetworkingPtrOutput)
+}
+
+// The subnets to use for the RDS instance.
+func (o NetworkingOutput) DbSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Networking) []string { return v.DbSubnetIds }).(pulumi.StringArrayOutput)
+}
+
+// The subnets to use for the Fargate task.
+func (o NetworkingOutput) EcsSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Networking) []string { return v.EcsSubnetIds }).(pulumi.StringArrayOutput)
+}
+
+// The subnets to use for the load balancer.
+func (o NetworkingOutput) LbSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Networking) []string { return v.LbSubnetIds }).(pulumi.StringArrayOutput)
+}
+
+type NetworkingPtrOutput struct{ *pulumi.OutputState }
+
+func (NetworkingPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Networking)(nil)).Elem()
+}
+
+func (o NetworkingPtrOutput) ToNetworkingPtrOutput() NetworkingPtrOutput {
+	return o
+}
+
+func (o NetworkingPtrOutput) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
+	return o
+}
+
+func (o NetworkingPtrOutput) Elem() NetworkingOutput {
+	return o.ApplyT(func(v *Networking) Networking {
+		if v != nil {
+			return *v
+		}
+		var ret Networking
+		return ret
+	}).(NetworkingOutput)
+}
+
+// The subnets to use for the RDS instance.
+func (o NetworkingPtrOutput) DbSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Networking) []string {
+		if v == nil {
+			return nil
+		}
+		return v.DbSubnetIds
+	}).(pulumi.StringArrayOutput)
+}
+
+// The subnets to use for the Fargate task.
+func (o NetworkingPtrOutput) EcsSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Networking) []string {
+		if v == nil {
+			return nil
+		}
+		return v.EcsSubnetIds
+	}).(pulumi.StringArrayOutput)
+}
+
+// The subnets to use for the load balancer.
+func (o NetworkingPtrOutput) LbSubnetIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Networking) []string {
+		if v == nil {
+			return nil
+		}
+		return v.LbSubnetIds
+	}).(pulumi.StringArrayOutput)
+}
+
+// A Metabase plugin JAR, such as a community database driver.
+type Plugin struct {
+	// The hex encoded SHA-256 checksum of the JAR. The plugin isn't installed if it doesn't match.
+	Sha256 string `pulumi:"sha256"`
+	// The https URL to download the JAR from.
+	Url string `pulumi:"url"`
+}
+
+// PluginInput is an input type that accepts PluginArgs and PluginOutput values.
+// You can construct a concrete instance of `PluginInput` via:
+//
+//	PluginArgs{...}
+type PluginInput interface {
+	pulumi.Input
+
+	ToPluginOutput() PluginOutput
+	ToPluginOutputWithContext(context.Context) PluginOutput
+}
+
+// A Metabase plugin JAR, such as a community database driver.
+type PluginArgs struct {
+	// The hex encoded SHA-256 checksum of the JAR. The plugin isn't installed if it doesn't match.
+	Sha256 string `pulumi:"sha256"`
+	// The https URL to download the JAR from.
+	Url string `pulumi:"url"`
+}
+
+func (PluginArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Plugin)(nil)).Elem()
+}
+
+func (i PluginArgs) ToPluginOutput() PluginOutput {
+	return i.ToPluginOutputWithContext(context.Background())
+}
+
+func (i PluginArgs) ToPluginOutputWithContext(ctx context.Context) PluginOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PluginOutput)
+}
+
+// A Metabase plugin JAR, such as a community database driver.
+type PluginOutput struct{ *pulumi.OutputState }
+
+func (PluginOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Plugin)(nil)).Elem()
+}
+
+func (o PluginOutput) ToPluginOutput() PluginOutput {
+	return o
+}
+
+func (o PluginOutput) ToPluginOutputWithContext(ctx context.Context) PluginOutput {
+	return o
+}
+
+// The hex encoded SHA-256 checksum of the JAR. The plugin isn't installed if it doesn't match.
+func (o PluginOutput) Sha256() pulumi.StringOutput {
+	return o.ApplyT(func(v Plugin) string { return v.Sha256 }).(pulumi.StringOutput)
+}
+
+// The https URL to download the JAR from.
+func (o PluginOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v Plugin) string { return v.Url }).(pulumi.StringOutput)
+}
+
+// A schedule to stop Metabase outside working hours by scaling the service to zero tasks and back to one.
+// The Aurora Serverless application database pauses once Metabase has been stopped for five minutes.
+type Schedule struct {
+	// When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+	// `0 19 ? * MON-FRI *` for 7pm on weekdays.
+	ScaleDown string `pulumi:"scaleDown"`
+	// When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+	ScaleUp string `pulumi:"scaleUp"`
+	// The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+	Timezone *string `pulumi:"timezone"`
+}
+
+// ScheduleInput is an input type that accepts ScheduleArgs and ScheduleOutput values.
+// You can construct a concrete instance of `ScheduleInput` via:
+//
+//	ScheduleArgs{...}
+type ScheduleInput interface {
+	pulumi.Input
+
+	ToScheduleOutput() ScheduleOutput
+	ToScheduleOutputWithContext(context.Context) ScheduleOutput
+}
+
+// A schedule to stop Metabase outside working hours by scaling the service to zero tasks and back to one.
+// The Aurora Serverless application database pauses once Metabase has been stopped for five minutes.
+type ScheduleArgs struct {
+	// When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+	// `0 19 ? * MON-FRI *` for 7pm on weekdays.
+	ScaleDown string `pulumi:"scaleDown"`
+	// When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+	ScaleUp string `pulumi:"scaleUp"`
+	// The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+	Timezone *string `pulumi:"timezone"`
+}
+
+func (ScheduleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Schedule)(nil)).Elem()
+}
+
+func (i ScheduleArgs) ToScheduleOutput() ScheduleOutput {
+	return i.ToScheduleOutputWithContext(context.Background())
+}
+
+func (i ScheduleArgs) ToScheduleOutputWithContext(ctx context.Context) ScheduleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScheduleOutput)
+}
+
+func (i ScheduleArgs) ToSchedulePtrOutput() SchedulePtrOutput {
+	return i.ToSchedulePtrOutputWithContext(context.Background())
+}
+
+func (i ScheduleArgs) ToSchedulePtrOutputWithContext(ctx context.Context) SchedulePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScheduleOutput).ToSchedulePtrOutputWithContext(ctx)
+}
+
+// SchedulePtrInput is an input type that accepts ScheduleArgs, SchedulePtr and SchedulePtrOutput values.
+// You can construct a concrete instance of `SchedulePtrInput` via:
+//
+//	        ScheduleArgs{...}
+//
+//	or:
+//
+//	        nil
+type SchedulePtrInput interface {
+	pulumi.Input
+
+	ToSchedulePtrOutput() SchedulePtrOutput
+	ToSchedulePtrOutputWithContext(context.Context) SchedulePtrOutput
+}
+
+type schedulePtrType ScheduleArgs
+
+func SchedulePtr(v *ScheduleArgs) SchedulePtrInput {
+	return (*schedulePtrType)(v)
+}
+
+func (*schedulePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Schedule)(nil)).Elem()
+}
+
+func (i *schedulePtrType) ToSchedulePtrOutput() SchedulePtrOutput {
+	return i.ToSchedulePtrOutputWithContext(context.Background())
+}
+
+func (i *schedulePtrType) ToSchedulePtrOutputWithContext(ctx context.Context) SchedulePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SchedulePtrOutput)
+}
+
+// A schedule to stop Metabase outside working hours by scaling the service to zero tasks and back to one.
+// The Aurora Serverless application database pauses once Metabase has been stopped for five minutes.
+type ScheduleOutput struct{ *pulumi.OutputState }
+
+func (ScheduleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Schedule)(nil)).Elem()
+}
+
+func (o ScheduleOutput) ToScheduleOutput() ScheduleOutput {
+	return o
+}
+
+func (o ScheduleOutput) ToScheduleOutputWithContext(ctx context.Context) ScheduleOutput {
+	return o
+}
+
+func (o ScheduleOutput) ToSchedulePtrOutput() SchedulePtrOutput {
+	return o.ToSchedulePtrOutputWithContext(context.Background())
+}
+
+func (o ScheduleOutput) ToSchedulePtrOutputWithContext(ctx context.Context) SchedulePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Schedule) *Schedule {
+		return &v
+	}).(SchedulePtrOutput)
+}
+
+// When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+// `0 19 ? * MON-FRI *` for 7pm on weekdays.
+func (o ScheduleOutput) ScaleDown() pulumi.StringOutput {
+	return o.ApplyT(func(v Schedule) string { return v.ScaleDown }).(pulumi.StringOutput)
+}
+
+// When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+func (o ScheduleOutput) ScaleUp() pulumi.StringOutput {
+	return o.ApplyT(func(v Schedule) string { return v.ScaleUp }).(pulumi.StringOutput)
+}
+
+// The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+func (o ScheduleOutput) Timezone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Schedule) *string { return v.Timezone }).(pulumi.StringPtrOutput)
+}
+
+type SchedulePtrOutput struct{ *pulumi.OutputState }
+
+func (SchedulePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Schedule)(nil)).Elem()
+}
+
+func (o SchedulePtrOutput) ToSchedulePtrOutput() SchedulePtrOutput {
+	return o
+}
+
+func (o SchedulePtrOutput) ToSchedulePtrOutputWithContext(ctx context.Context) SchedulePtrOutput {
+	return o
+}
+
+func (o SchedulePtrOutput) Elem() ScheduleOutput {
+	return o.ApplyT(func(v *Schedule) Schedule {
+		if v != nil {
+			return *v
+		}
+		var ret Schedule
+		return ret
+	}).(ScheduleOutput)
+}
+
+// When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+// `0 19 ? * MON-FRI *` for 7pm on weekdays.
+func (o SchedulePtrOutput) ScaleDown() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Schedule) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.ScaleDown
+	}).(pulumi.StringPtrOutput)
+}
+
+// When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+func (o SchedulePtrOutput) ScaleUp() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Schedule) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.ScaleUp
+	}).(pulumi.StringPtrOutput)
+}
+
+// The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+func (o SchedulePtrOutput) Timezone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Schedule) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Timezone
+	}).(pulumi.StringPtrOutput)
+}
+
+// Commonly used Metabase application settings. Each setting is passed to Metabase through its `MB_*`
+// [environment variable](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables)
+// and can't also be set through `environment` or `secrets`.
+type Settings struct {
+	// The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+	AdminEmail *string `pulumi:"adminEmail"`
+	// Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+	AnonTrackingEnabled *bool `pulumi:"anonTrackingEnabled"`
+	// Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+	EnableQueryCaching *bool `pulumi:"enableQueryCaching"`
+	// The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+	PasswordComplexity *string `pulumi:"passwordComplexity"`
+	// The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+	SessionTimeoutMinutes *int `pulumi:"sessionTimeoutMinutes"`
+	// The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+	SiteLocale *string `pulumi:"siteLocale"`
+	// The name used for this Metabase instance (`MB_SITE_NAME`).
+	SiteName *string `pulumi:"siteName"`
+	// The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+	// custom domain when one is configured, and to the load balancer's DNS name otherwise.
+	SiteUrl *string `pulumi:"siteUrl"`
+	// The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+	Timezone *string `pulumi:"timezone"`
+}
+
+// SettingsInput is an input type that accepts SettingsArgs and SettingsOutput values.
+// You can construct a concrete instance of `SettingsInput` via:
 //
-//          CustomDomainArgs{...}
-type CustomDomainInput interface {
+//	SettingsArgs{...}
+type SettingsInput interface {
 	pulumi.Input
 
-	ToCustomDomainOutput() CustomDomainOutput
-	ToCustomDomainOutputWithContext(context.Context) CustomDomainOutput
+	ToSettingsOutput() SettingsOutput
+	ToSettingsOutputWithContext(context.Context) SettingsOutput
 }
 
-// Options for setting a custom domain.
-type CustomDomainArgs struct {
-	DomainName     pulumi.StringPtrInput `pulumi:"domainName"`
-	HostedZoneName pulumi.StringPtrInput `pulumi:"hostedZoneName"`
+// Commonly used Metabase application settings. Each setting is passed to Metabase through its `MB_*`
+// [environment variable](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables)
+// and can't also be set through `environment` or `secrets`.
+type SettingsArgs struct {
+	// The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+	AdminEmail *string `pulumi:"adminEmail"`
+	// Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+	AnonTrackingEnabled *bool `pulumi:"anonTrackingEnabled"`
+	// Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+	EnableQueryCaching *bool `pulumi:"enableQueryCaching"`
+	// The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+	PasswordComplexity *string `pulumi:"passwordComplexity"`
+	// The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+	SessionTimeoutMinutes *int `pulumi:"sessionTimeoutMinutes"`
+	// The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+	SiteLocale *string `pulumi:"siteLocale"`
+	// The name used for this Metabase instance (`MB_SITE_NAME`).
+	SiteName *string `pulumi:"siteName"`
+	// The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+	// custom domain when one is configured, and to the load balancer's DNS name otherwise.
+	SiteUrl *string `pulumi:"siteUrl"`
+	// The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+	Timezone *string `pulumi:"timezone"`
 }
 
-func (CustomDomainArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*CustomDomain)(nil)).Elem()
+func (SettingsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Settings)(nil)).Elem()
 }
 
-func (i CustomDomainArgs) ToCustomDomainOutput() CustomDomainOutput {
-	return i.ToCustomDomainOutputWithContext(context.Background())
+func (i SettingsArgs) ToSettingsOutput() SettingsOutput {
+	return i.ToSettingsOutputWithContext(context.Background())
 }
 
-func (i CustomDomainArgs) ToCustomDomainOutputWithContext(ctx context.Context) CustomDomainOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CustomDomainOutput)
+func (i SettingsArgs) ToSettingsOutputWithContext(ctx context.Context) SettingsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SettingsOutput)
 }
 
-func (i CustomDomainArgs) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
-	return i.ToCustomDomainPtrOutputWithContext(context.Background())
+func (i SettingsArgs) ToSettingsPtrOutput() SettingsPtrOutput {
+	return i.ToSettingsPtrOutputWithContext(context.Background())
 }
 
-func (i CustomDomainArgs) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CustomDomainOutput).ToCustomDomainPtrOutputWithContext(ctx)
+func (i SettingsArgs) ToSettingsPtrOutputWithContext(ctx context.Context) SettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SettingsOutput).ToSettingsPtrOutputWithContext(ctx)
 }
 
-// CustomDomainPtrInput is an input type that accepts CustomDomainArgs, CustomDomainPtr and CustomDomainPtrOutput values.
-// You can construct a concrete instance of `CustomDomainPtrInput` via:
+// SettingsPtrInput is an input type that accepts SettingsArgs, SettingsPtr and SettingsPtrOutput values.
+// You can construct a concrete instance of `SettingsPtrInput` via:
 //
-//          CustomDomainArgs{...}
+//	        SettingsArgs{...}
 //
-//  or:
+//	or:
 //
-//          nil
-type CustomDomainPtrInput interface {
+//	        nil
+type SettingsPtrInput interface {
 	pulumi.Input
 
-	ToCustomDomainPtrOutput() CustomDomainPtrOutput
-	ToCustomDomainPtrOutputWithContext(context.Context) CustomDomainPtrOutput
+	ToSettingsPtrOutput() SettingsPtrOutput
+	ToSettingsPtrOutputWithContext(context.Context) SettingsPtrOutput
 }
 
-type customDomainPtrType CustomDomainArgs
+type settingsPtrType SettingsArgs
+
+func SettingsPtr(v *SettingsArgs) SettingsPtrInput {
+	return (*settingsPtrType)(v)
+}
+
+func (*settingsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Settings)(nil)).Elem()
+}
+
+func (i *settingsPtrType) ToSettingsPtrOutput() SettingsPtrOutput {
+	return i.ToSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i *settingsPtrType) ToSettingsPtrOutputWithContext(ctx context.Context) SettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SettingsPtrOutput)
+}
+
+// Commonly used Metabase application settings. Each setting is passed to Metabase through its `MB_*`
+// [environment variable](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables)
+// and can't also be set through `environment` or `secrets`.
+type SettingsOutput struct{ *pulumi.OutputState }
+
+func (SettingsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Settings)(nil)).Elem()
+}
+
+func (o SettingsOutput) ToSettingsOutput() SettingsOutput {
+	return o
+}
+
+func (o SettingsOutput) ToSettingsOutputWithContext(ctx context.Context) SettingsOutput {
+	return o
+}
+
+func (o SettingsOutput) ToSettingsPtrOutput() SettingsPtrOutput {
+	return o.ToSettingsPtrOutputWithContext(context.Background())
+}
+
+func (o SettingsOutput) ToSettingsPtrOutputWithContext(ctx context.Context) SettingsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Settings) *Settings {
+		return &v
+	}).(SettingsPtrOutput)
+}
+
+// The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+func (o SettingsOutput) AdminEmail() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Settings) *string { return v.AdminEmail }).(pulumi.StringPtrOutput)
+}
+
+// Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+func (o SettingsOutput) AnonTrackingEnabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Settings) *bool { return v.AnonTrackingEnabled }).(pulumi.BoolPtrOutput)
+}
+
+// Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+func (o SettingsOutput) EnableQueryCaching() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Settings) *bool { return v.EnableQueryCaching }).(pulumi.BoolPtrOutput)
+}
+
+// The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+func (o SettingsOutput) PasswordComplexity() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Settings) *string { return v.PasswordComplexity }).(pulumi.StringPtrOutput)
+}
+
+// The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+func (o SettingsOutput) SessionTimeoutMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Settings) *int { return v.SessionTimeoutMinutes }).(pulumi.IntPtrOutput)
+}
+
+// The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+func (o SettingsOutput) SiteLocale() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Settings) *string { return v.SiteLocale }).(pulumi.StringPtrOutput)
+}
+
+// The name used for this Metabase instance (`MB_SITE_NAME`).
+func (o SettingsOutput) SiteName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Settings) *string { return v.SiteName }).(pulumi.StringPtrOutput)
+}
+
+// The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+// custom domain when one is configured, and to the load balancer's DNS name otherwise.
+func (o SettingsOutput) SiteUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Settings) *string { return v.SiteUrl }).(pulumi.StringPtrOutput)
+}
+
+// The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+func (o SettingsOutput) Timezone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Settings) *string { return v.Timezone }).(pulumi.StringPtrOutput)
+}
+
+type SettingsPtrOutput struct{ *pulumi.OutputState }
+
+func (SettingsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Settings)(nil)).Elem()
+}
+
+func (o SettingsPtrOutput) ToSettingsPtrOutput() SettingsPtrOutput {
+	return o
+}
+
+func (o SettingsPtrOutput) ToSettingsPtrOutputWithContext(ctx context.Context) SettingsPtrOutput {
+	return o
+}
+
+func (o SettingsPtrOutput) Elem() SettingsOutput {
+	return o.ApplyT(func(v *Settings) Settings {
+		if v != nil {
+			return *v
+		}
+		var ret Settings
+		return ret
+	}).(SettingsOutput)
+}
+
+// The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+func (o SettingsPtrOutput) AdminEmail() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Settings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AdminEmail
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+func (o SettingsPtrOutput) AnonTrackingEnabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Settings) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.AnonTrackingEnabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+func (o SettingsPtrOutput) EnableQueryCaching() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Settings) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableQueryCaching
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+func (o SettingsPtrOutput) PasswordComplexity() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Settings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.PasswordComplexity
+	}).(pulumi.StringPtrOutput)
+}
+
+// The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+func (o SettingsPtrOutput) SessionTimeoutMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Settings) *int {
+		if v == nil {
+			return nil
+		}
+		return v.SessionTimeoutMinutes
+	}).(pulumi.IntPtrOutput)
+}
+
+// The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+func (o SettingsPtrOutput) SiteLocale() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Settings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SiteLocale
+	}).(pulumi.StringPtrOutput)
+}
+
+// The name used for this Metabase instance (`MB_SITE_NAME`).
+func (o SettingsPtrOutput) SiteName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Settings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SiteName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+// custom domain when one is configured, and to the load balancer's DNS name otherwise.
+func (o SettingsPtrOutput) SiteUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Settings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SiteUrl
+	}).(pulumi.StringPtrOutput)
+}
+
+// The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+func (o SettingsPtrOutput) Timezone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Settings) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Timezone
+	}).(pulumi.StringPtrOutput)
+}
+
+// An extra container run in the Metabase task, such as a monitoring agent or a telemetry collector.
+type Sidecar struct {
+	// The other sidecars this container waits for before it's started.
+	DependsOn []ContainerDependency `pulumi:"dependsOn"`
+	// Environment variables for the container.
+	Environment map[string]string `pulumi:"environment"`
+	// Whether the task is stopped when the container exits. Defaults to `true`.
+	Essential *bool `pulumi:"essential"`
+	// A Docker health check for the container, required to depend on it being `HEALTHY`.
+	HealthCheck *SidecarHealthCheck `pulumi:"healthCheck"`
+	// The image to run.
+	Image string `pulumi:"image"`
+	// The condition the container has to reach before Metabase is started, one of `START`, `COMPLETE`,
+	// `SUCCESS` or `HEALTHY`. Metabase doesn't wait for the container when not set.
+	MetabaseDependsOn *string `pulumi:"metabaseDependsOn"`
+	// The name of the container. `metabase`, `log-router` and `plugins-init` are reserved by the component.
+	Name string `pulumi:"name"`
+	// The ports the container listens on. Port 3000 is used by Metabase.
+	PortMappings []SidecarPortMapping `pulumi:"portMappings"`
+	// Environment variables whose values are read from Secrets Manager or SSM Parameter Store, as a map of
+	// variable name to secret ARN. The task execution role is granted access to these secrets.
+	Secrets map[string]string `pulumi:"secrets"`
+}
+
+// SidecarInput is an input type that accepts SidecarArgs and SidecarOutput values.
+// You can construct a concrete instance of `SidecarInput` via:
+//
+//	SidecarArgs{...}
+type SidecarInput interface {
+	pulumi.Input
+
+	ToSidecarOutput() SidecarOutput
+	ToSidecarOutputWithContext(context.Context) SidecarOutput
+}
+
+// An extra container run in the Metabase task, such as a monitoring agent or a telemetry collector.
+type SidecarArgs struct {
+	// The other sidecars this container waits for before it's started.
+	DependsOn []ContainerDependencyInput `pulumi:"dependsOn"`
+	// Environment variables for the container.
+	Environment pulumi.StringMapInput `pulumi:"environment"`
+	// Whether the task is stopped when the container exits. Defaults to `true`.
+	Essential *bool `pulumi:"essential"`
+	// A Docker health check for the container, required to depend on it being `HEALTHY`.
+	HealthCheck SidecarHealthCheckPtrInput `pulumi:"healthCheck"`
+	// The image to run.
+	Image pulumi.StringInput `pulumi:"image"`
+	// The condition the container has to reach before Metabase is started, one of `START`, `COMPLETE`,
+	// `SUCCESS` or `HEALTHY`. Metabase doesn't wait for the container when not set.
+	MetabaseDependsOn *string `pulumi:"metabaseDependsOn"`
+	// The name of the container. `metabase`, `log-router` and `plugins-init` are reserved by the component.
+	Name string `pulumi:"name"`
+	// The ports the container listens on. Port 3000 is used by Metabase.
+	PortMappings []SidecarPortMappingInput `pulumi:"portMappings"`
+	// Environment variables whose values are read from Secrets Manager or SSM Parameter Store, as a map of
+	// variable name to secret ARN. The task execution role is granted access to these secrets.
+	Secrets pulumi.StringMapInput `pulumi:"secrets"`
+}
+
+func (SidecarArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Sidecar)(nil)).Elem()
+}
+
+func (i SidecarArgs) ToSidecarOutput() SidecarOutput {
+	return i.ToSidecarOutputWithContext(context.Background())
+}
+
+func (i SidecarArgs) ToSidecarOutputWithContext(ctx context.Context) SidecarOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SidecarOutput)
+}
+
+// An extra container run in the Metabase task, such as a monitoring agent or a telemetry collector.
+type SidecarOutput struct{ *pulumi.OutputState }
+
+func (SidecarOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Sidecar)(nil)).Elem()
+}
+
+func (o SidecarOutput) ToSidecarOutput() SidecarOutput {
+	return o
+}
+
+func (o SidecarOutput) ToSidecarOutputWithContext(ctx context.Context) SidecarOutput {
+	return o
+}
+
+// The other sidecars this container waits for before it's started.
+func (o SidecarOutput) DependsOn() ContainerDependencyArrayOutput {
+	return o.ApplyT(func(v Sidecar) []ContainerDependency { return v.DependsOn }).(ContainerDependencyArrayOutput)
+}
+
+// Environment variables for the container.
+func (o SidecarOutput) Environment() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Sidecar) map[string]string { return v.Environment }).(pulumi.StringMapOutput)
+}
+
+// Whether the task is stopped when the container exits. Defaults to `true`.
+func (o SidecarOutput) Essential() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Sidecar) *bool { return v.Essential }).(pulumi.BoolPtrOutput)
+}
+
+// A Docker health check for the container, required to depend on it being `HEALTHY`.
+func (o SidecarOutput) HealthCheck() SidecarHealthCheckPtrOutput {
+	return o.ApplyT(func(v Sidecar) *SidecarHealthCheck { return v.HealthCheck }).(SidecarHealthCheckPtrOutput)
+}
+
+// The image to run.
+func (o SidecarOutput) Image() pulumi.StringOutput {
+	return o.ApplyT(func(v Sidecar) string { return v.Image }).(pulumi.StringOutput)
+}
+
+// The condition the container has to reach before Metabase is started, one of `START`, `COMPLETE`,
+// `SUCCESS` or `HEALTHY`. Metabase doesn't wait for the container when not set.
+func (o SidecarOutput) MetabaseDependsOn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Sidecar) *string { return v.MetabaseDependsOn }).(pulumi.StringPtrOutput)
+}
+
+// The name of the container. `metabase`, `log-router` and `plugins-init` are reserved by the component.
+func (o SidecarOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v Sidecar) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The ports the container listens on. Port 3000 is used by Metabase.
+func (o SidecarOutput) PortMappings() SidecarPortMappingArrayOutput {
+	return o.ApplyT(func(v Sidecar) []SidecarPortMapping { return v.PortMappings }).(SidecarPortMappingArrayOutput)
+}
+
+// Environment variables whose values are read from Secrets Manager or SSM Parameter Store, as a map of
+// variable name to secret ARN. The task execution role is granted access to these secrets.
+func (o SidecarOutput) Secrets() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Sidecar) map[string]string { return v.Secrets }).(pulumi.StringMapOutput)
+}
+
+// A Docker health check for a sidecar container.
+type SidecarHealthCheck struct {
+	// The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+	Command []string `pulumi:"command"`
+	// The number of seconds between health checks.
+	Interval *int `pulumi:"interval"`
+	// The number of consecutive failures before the container is unhealthy.
+	Retries *int `pulumi:"retries"`
+	// The number of seconds to give the container to start before failures count.
+	StartPeriod *int `pulumi:"startPeriod"`
+	// The number of seconds to wait for the command to succeed.
+	Timeout *int `pulumi:"timeout"`
+}
+
+// SidecarHealthCheckInput is an input type that accepts SidecarHealthCheckArgs and SidecarHealthCheckOutput values.
+// You can construct a concrete instance of `SidecarHealthCheckInput` via:
+//
+//	SidecarHealthCheckArgs{...}
+type SidecarHealthCheckInput interface {
+	pulumi.Input
+
+	ToSidecarHealthCheckOutput() SidecarHealthCheckOutput
+	ToSidecarHealthCheckOutputWithContext(context.Context) SidecarHealthCheckOutput
+}
+
+// A Docker health check for a sidecar container.
+type SidecarHealthCheckArgs struct {
+	// The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+	Command []pulumi.StringInput `pulumi:"command"`
+	// The number of seconds between health checks.
+	Interval *int `pulumi:"interval"`
+	// The number of consecutive failures before the container is unhealthy.
+	Retries *int `pulumi:"retries"`
+	// The number of seconds to give the container to start before failures count.
+	StartPeriod *int `pulumi:"startPeriod"`
+	// The number of seconds to wait for the command to succeed.
+	Timeout *int `pulumi:"timeout"`
+}
+
+func (SidecarHealthCheckArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SidecarHealthCheck)(nil)).Elem()
+}
+
+func (i SidecarHealthCheckArgs) ToSidecarHealthCheckOutput() SidecarHealthCheckOutput {
+	return i.ToSidecarHealthCheckOutputWithContext(context.Background())
+}
+
+func (i SidecarHealthCheckArgs) ToSidecarHealthCheckOutputWithContext(ctx context.Context) SidecarHealthCheckOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SidecarHealthCheckOutput)
+}
+
+func (i SidecarHealthCheckArgs) ToSidecarHealthCheckPtrOutput() SidecarHealthCheckPtrOutput {
+	return i.ToSidecarHealthCheckPtrOutputWithContext(context.Background())
+}
+
+func (i SidecarHealthCheckArgs) ToSidecarHealthCheckPtrOutputWithContext(ctx context.Context) SidecarHealthCheckPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SidecarHealthCheckOutput).ToSidecarHealthCheckPtrOutputWithContext(ctx)
+}
+
+// SidecarHealthCheckPtrInput is an input type that accepts SidecarHealthCheckArgs, SidecarHealthCheckPtr and SidecarHealthCheckPtrOutput values.
+// You can construct a concrete instance of `SidecarHealthCheckPtrInput` via:
+//
+//	        SidecarHealthCheckArgs{...}
+//
+//	or:
+//
+//	        nil
+type SidecarHealthCheckPtrInput interface {
+	pulumi.Input
+
+	ToSidecarHealthCheckPtrOutput() SidecarHealthCheckPtrOutput
+	ToSidecarHealthCheckPtrOutputWithContext(context.Context) SidecarHealthCheckPtrOutput
+}
+
+type sidecarHealthCheckPtrType SidecarHealthCheckArgs
 
-func CustomDomainPtr(v *CustomDomainArgs) CustomDomainPtrInput {
-	return (*customDomainPtrType)(v)
+func SidecarHealthCheckPtr(v *SidecarHealthCheckArgs) SidecarHealthCheckPtrInput {
+	return (*sidecarHealthCheckPtrType)(v)
 }
 
-func (*customDomainPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**CustomDomain)(nil)).Elem()
+func (*sidecarHealthCheckPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SidecarHealthCheck)(nil)).Elem()
 }
 
-func (i *customDomainPtrType) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
-	return i.ToCustomDomainPtrOutputWithContext(context.Background())
+func (i *sidecarHealthCheckPtrType) ToSidecarHealthCheckPtrOutput() SidecarHealthCheckPtrOutput {
+	return i.ToSidecarHealthCheckPtrOutputWithContext(context.Background())
 }
 
-func (i *customDomainPtrType) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CustomDomainPtrOutput)
+func (i *sidecarHealthCheckPtrType) ToSidecarHealthCheckPtrOutputWithContext(ctx context.Context) SidecarHealthCheckPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SidecarHealthCheckPtrOutput)
 }
 
-// Options for setting a custom domain.
-type CustomDomainOutput struct{ *pulumi.OutputState }
+// A Docker health check for a sidecar container.
+type SidecarHealthCheckOutput struct{ *pulumi.OutputState }
 
-func (CustomDomainOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*CustomDomain)(nil)).Elem()
+func (SidecarHealthCheckOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SidecarHealthCheck)(nil)).Elem()
 }
 
-func (o CustomDomainOutput) ToCustomDomainOutput() CustomDomainOutput {
+func (o SidecarHealthCheckOutput) ToSidecarHealthCheckOutput() SidecarHealthCheckOutput {
 	return o
 }
 
-func (o CustomDomainOutput) ToCustomDomainOutputWithContext(ctx context.Context) CustomDomainOutput {
+func (o SidecarHealthCheckOutput) ToSidecarHealthCheckOutputWithContext(ctx context.Context) SidecarHealthCheckOutput {
 	return o
 }
 
-func (o CustomDomainOutput) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
-	return o.ToCustomDomainPtrOutputWithContext(context.Background())
+func (o SidecarHealthCheckOutput) ToSidecarHealthCheckPtrOutput() SidecarHealthCheckPtrOutput {
+	return o.ToSidecarHealthCheckPtrOutputWithContext(context.Background())
 }
 
-func (o CustomDomainOutput) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v CustomDomain) *CustomDomain {
+func (o SidecarHealthCheckOutput) ToSidecarHealthCheckPtrOutputWithContext(ctx context.Context) SidecarHealthCheckPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SidecarHealthCheck) *SidecarHealthCheck {
 		return &v
-	}).(CustomDomainPtrOutput)
+	}).(SidecarHealthCheckPtrOutput)
 }
 
-func (o CustomDomainOutput) DomainName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v CustomDomain) *string { return v.DomainName }).(pulumi.StringPtrOutput)
+// The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+func (o SidecarHealthCheckOutput) Command() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v SidecarHealthCheck) []string { return v.Command }).(pulumi.StringArrayOutput)
 }
 
-func (o CustomDomainOutput) HostedZoneName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v CustomDomain) *string { return v.HostedZoneName }).(pulumi.StringPtrOutput)
+// The number of seconds between health checks.
+func (o SidecarHealthCheckOutput) Interval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SidecarHealthCheck) *int { return v.Interval }).(pulumi.IntPtrOutput)
 }
 
-type CustomDomainPtrOutput struct{ *pulumi.OutputState }
+// The number of consecutive failures before the container is unhealthy.
+func (o SidecarHealthCheckOutput) Retries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SidecarHealthCheck) *int { return v.Retries }).(pulumi.IntPtrOutput)
+}
 
-func (CustomDomainPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**CustomDomain)(nil)).Elem()
+// The number of seconds to give the container to start before failures count.
+func (o SidecarHealthCheckOutput) StartPeriod() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SidecarHealthCheck) *int { return v.StartPeriod }).(pulumi.IntPtrOutput)
 }
 
-func (o CustomDomainPtrOutput) ToCustomDomainPtrOutput() CustomDomainPtrOutput {
+// The number of seconds to wait for the command to succeed.
+func (o SidecarHealthCheckOutput) Timeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SidecarHealthCheck) *int { return v.Timeout }).(pulumi.IntPtrOutput)
+}
+
+type SidecarHealthCheckPtrOutput struct{ *pulumi.OutputState }
+
+func (SidecarHealthCheckPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SidecarHealthCheck)(nil)).Elem()
+}
+
+func (o SidecarHealthCheckPtrOutput) ToSidecarHealthCheckPtrOutput() SidecarHealthCheckPtrOutput {
 	return o
 }
 
-func (o CustomDomainPtrOutput) ToCustomDomainPtrOutputWithContext(ctx context.Context) CustomDomainPtrOutput {
+func (o SidecarHealthCheckPtrOutput) ToSidecarHealthCheckPtrOutputWithContext(ctx context.Context) SidecarHealthCheckPtrOutput {
 	return o
 }
 
-func (o CustomDomainPtrOutput) Elem() CustomDomainOutput {
-	return o.ApplyT(func(v *CustomDomain) CustomDomain {
+func (o SidecarHealthCheckPtrOutput) Elem() SidecarHealthCheckOutput {
+	return o.ApplyT(func(v *SidecarHealthCheck) SidecarHealthCheck {
 		if v != nil {
 			return *v
 		}
-		var ret CustomDomain
+		var ret SidecarHealthCheck
 		return ret
-	}).(CustomDomainOutput)
+	}).(SidecarHealthCheckOutput)
 }
 
-func (o CustomDomainPtrOutput) DomainName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *CustomDomain) *string {
+// The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+func (o SidecarHealthCheckPtrOutput) Command() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *SidecarHealthCheck) []string {
 		if v == nil {
 			return nil
 		}
-		return v.DomainName
-	}).(pulumi.StringPtrOutput)
+		return v.Command
+	}).(pulumi.StringArrayOutput)
 }
 
-func (o CustomDomainPtrOutput) HostedZoneName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *CustomDomain) *string {
+// The number of seconds between health checks.
+func (o SidecarHealthCheckPtrOutput) Interval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SidecarHealthCheck) *int {
 		if v == nil {
 			return nil
 		}
-		return v.HostedZoneName
-	}).(pulumi.StringPtrOutput)
+		return v.Interval
+	}).(pulumi.IntPtrOutput)
 }
 
-// The options for configuring your database.
-type Database struct {
-	// The database engine version. Updating this argument results in an outage. See the
-	// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
-	// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
-	// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-	// where supported by the API.
-	EngineVersion *string `pulumi:"engineVersion"`
+// The number of consecutive failures before the container is unhealthy.
+func (o SidecarHealthCheckPtrOutput) Retries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SidecarHealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Retries
+	}).(pulumi.IntPtrOutput)
 }
 
-// Defaults sets the appropriate defaults for Database
-func (val *Database) Defaults() *Database {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if isZero(tmp.EngineVersion) {
-		engineVersion_ := "5.7.mysql_aurora.2.08.3"
-		tmp.EngineVersion = &engineVersion_
-	}
-	return &tmp
+// The number of seconds to give the container to start before failures count.
+func (o SidecarHealthCheckPtrOutput) StartPeriod() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SidecarHealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.StartPeriod
+	}).(pulumi.IntPtrOutput)
 }
 
-// DatabaseInput is an input type that accepts DatabaseArgs and DatabaseOutput values.
-// You can construct a concrete instance of `DatabaseInput` via:
-//
-//          DatabaseArgs{...}
-type DatabaseInput interface {
-	pulumi.Input
-
-	ToDatabaseOutput() DatabaseOutput
-	ToDatabaseOutputWithContext(context.Context) DatabaseOutput
+// The number of seconds to wait for the command to succeed.
+func (o SidecarHealthCheckPtrOutput) Timeout() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SidecarHealthCheck) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Timeout
+	}).(pulumi.IntPtrOutput)
 }
 
-// The options for configuring your database.
-type DatabaseArgs struct {
-	// The database engine version. Updating this argument results in an outage. See the
-	// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
-	// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
-	// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-	// where supported by the API.
-	EngineVersion pulumi.StringPtrInput `pulumi:"engineVersion"`
+// A port a sidecar container listens on.
+type SidecarPortMapping struct {
+	// The port number.
+	ContainerPort int `pulumi:"containerPort"`
+	// Either `tcp` or `udp`. Defaults to `tcp`.
+	Protocol *string `pulumi:"protocol"`
 }
 
-func (DatabaseArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*Database)(nil)).Elem()
+// SidecarPortMappingInput is an input type that accepts SidecarPortMappingArgs and SidecarPortMappingOutput values.
+// You can construct a concrete instance of `SidecarPortMappingInput` via:
+//
+//	SidecarPortMappingArgs{...}
+type SidecarPortMappingInput interface {
+	pulumi.Input
+
+	ToSidecarPortMappingOutput() SidecarPortMappingOutput
+	ToSidecarPortMappingOutputWithContext(context.Context) SidecarPortMappingOutput
 }
 
-func (i DatabaseArgs) ToDatabaseOutput() DatabaseOutput {
-	return i.ToDatabaseOutputWithContext(context.Background())
+// A port a sidecar container listens on.
+type SidecarPortMappingArgs struct {
+	// The port number.
+	ContainerPort int `pulumi:"containerPort"`
+	// Either `tcp` or `udp`. Defaults to `tcp`.
+	Protocol *string `pulumi:"protocol"`
 }
 
-func (i DatabaseArgs) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(DatabaseOutput)
+func (SidecarPortMappingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SidecarPortMapping)(nil)).Elem()
 }
 
-func (i DatabaseArgs) ToDatabasePtrOutput() DatabasePtrOutput {
-	return i.ToDatabasePtrOutputWithContext(context.Background())
+func (i SidecarPortMappingArgs) ToSidecarPortMappingOutput() SidecarPortMappingOutput {
+	return i.ToSidecarPortMappingOutputWithContext(context.Background())
 }
 
-func (i DatabaseArgs) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(DatabaseOutput).ToDatabasePtrOutputWithContext(ctx)
+func (i SidecarPortMappingArgs) ToSidecarPortMappingOutputWithContext(ctx context.Context) SidecarPortMappingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SidecarPortMappingOutput)
 }
 
-// DatabasePtrInput is an input type that accepts DatabaseArgs, DatabasePtr and DatabasePtrOutput values.
-// You can construct a concrete instance of `DatabasePtrInput` via:
-//
-//          DatabaseArgs{...}
+// SidecarPortMappingArrayInput is an input type that accepts SidecarPortMappingArray and SidecarPortMappingArrayOutput values.
+// You can construct a concrete instance of `SidecarPortMappingArrayInput` via:
 //
-//  or:
-//
-//          nil
-type DatabasePtrInput interface {
+//	SidecarPortMappingArray{ SidecarPortMappingArgs{...} }
+type SidecarPortMappingArrayInput interface {
 	pulumi.Input
 
-	ToDatabasePtrOutput() DatabasePtrOutput
-	ToDatabasePtrOutputWithContext(context.Context) DatabasePtrOutput
+	ToSidecarPortMappingArrayOutput() SidecarPortMappingArrayOutput
+	ToSidecarPortMappingArrayOutputWithContext(context.Context) SidecarPortMappingArrayOutput
 }
 
-type databasePtrType DatabaseArgs
-
-func DatabasePtr(v *DatabaseArgs) DatabasePtrInput {
-	return (*databasePtrType)(v)
-}
+type SidecarPortMappingArray []SidecarPortMappingInput
 
-func (*databasePtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**Database)(nil)).Elem()
+func (SidecarPortMappingArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SidecarPortMapping)(nil)).Elem()
 }
 
-func (i *databasePtrType) ToDatabasePtrOutput() DatabasePtrOutput {
-	return i.ToDatabasePtrOutputWithContext(context.Background())
+func (i SidecarPortMappingArray) ToSidecarPortMappingArrayOutput() SidecarPortMappingArrayOutput {
+	return i.ToSidecarPortMappingArrayOutputWithContext(context.Background())
 }
 
-func (i *databasePtrType) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(DatabasePtrOutput)
+func (i SidecarPortMappingArray) ToSidecarPortMappingArrayOutputWithContext(ctx context.Context) SidecarPortMappingArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SidecarPortMappingArrayOutput)
 }
 
-// The options for configuring your database.
-type DatabaseOutput struct{ *pulumi.OutputState }
+// A port a sidecar container listens on.
+type SidecarPortMappingOutput struct{ *pulumi.OutputState }
 
-func (DatabaseOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*Database)(nil)).Elem()
+func (SidecarPortMappingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SidecarPortMapping)(nil)).Elem()
 }
 
-func (o DatabaseOutput) ToDatabaseOutput() DatabaseOutput {
+func (o SidecarPortMappingOutput) ToSidecarPortMappingOutput() SidecarPortMappingOutput {
 	return o
 }
 
-func (o DatabaseOutput) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
+func (o SidecarPortMappingOutput) ToSidecarPortMappingOutputWithContext(ctx context.Context) SidecarPortMappingOutput {
 	return o
 }
 
-func (o DatabaseOutput) ToDatabasePtrOutput() DatabasePtrOutput {
-	return o.ToDatabasePtrOutputWithContext(context.Background())
-}
-
-func (o DatabaseOutput) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Database) *Database {
-		return &v
-	}).(DatabasePtrOutput)
+// The port number.
+func (o SidecarPortMappingOutput) ContainerPort() pulumi.IntOutput {
+	return o.ApplyT(func(v SidecarPortMapping) int { return v.ContainerPort }).(pulumi.IntOutput)
 }
 
-// The database engine version. Updating this argument results in an outage. See the
-// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
-// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
-// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-// where supported by the API.
-func (o DatabaseOutput) EngineVersion() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Database) *string { return v.EngineVersion }).(pulumi.StringPtrOutput)
+// Either `tcp` or `udp`. Defaults to `tcp`.
+func (o SidecarPortMappingOutput) Protocol() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SidecarPortMapping) *string { return v.Protocol }).(pulumi.StringPtrOutput)
 }
 
-type DatabasePtrOutput struct{ *pulumi.OutputState }
+type SidecarPortMappingArrayOutput struct{ *pulumi.OutputState }
 
-func (DatabasePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Database)(nil)).Elem()
+func (SidecarPortMappingArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SidecarPortMapping)(nil)).Elem()
 }
 
-func (o DatabasePtrOutput) ToDatabasePtrOutput() DatabasePtrOutput {
+func (o SidecarPortMappingArrayOutput) ToSidecarPortMappingArrayOutput() SidecarPortMappingArrayOutput {
 	return o
 }
 
-func (o DatabasePtrOutput) ToDatabasePtrOutputWithContext(ctx context.Context) DatabasePtrOutput {
+func (o SidecarPortMappingArrayOutput) ToSidecarPortMappingArrayOutputWithContext(ctx context.Context) SidecarPortMappingArrayOutput {
 	return o
 }
 
-func (o DatabasePtrOutput) Elem() DatabaseOutput {
-	return o.ApplyT(func(v *Database) Database {
-		if v != nil {
-			return *v
-		}
-		var ret Database
-		return ret
-	}).(DatabaseOutput)
-}
-
-// The database engine version. Updating this argument results in an outage. See the
-// [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
-// documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
-// a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-// where supported by the API.
-func (o DatabasePtrOutput) EngineVersion() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Database) *string {
-		if v == nil {
-			return nil
-		}
-		return v.EngineVersion
-	}).(pulumi.StringPtrOutput)
+func (o SidecarPortMappingArrayOutput) Index(i pulumi.IntInput) SidecarPortMappingOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SidecarPortMapping {
+		return vs[0].([]SidecarPortMapping)[vs[1].(int)]
+	}).(SidecarPortMappingOutput)
 }
 
-// The options for networking.
-type Networking struct {
-	// The subnets to use for the RDS instance.
-	DbSubnetIds []string `pulumi:"dbSubnetIds"`
-	// The subnets to use for the Fargate task.
-	EcsSubnetIds []string `pulumi:"ecsSubnetIds"`
-	// The subnets to use for the load balancer.
-	LbSubnetIds []string `pulumi:"lbSubnetIds"`
+// Options for the IAM role assumed by the Metabase container. Use it to let Metabase connect to data
+// sources such as Athena, S3 or Redshift with IAM credentials.
+type TaskRole struct {
+	// The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+	AthenaResultsBucket *string `pulumi:"athenaResultsBucket"`
+	// Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+	InlinePolicies map[string]string `pulumi:"inlinePolicies"`
+	// The ARNs of IAM managed policies to attach to the task role.
+	ManagedPolicyArns []string `pulumi:"managedPolicyArns"`
+	// Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+	// which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+	// Access to the S3 buckets backing Athena tables must be granted separately, for example with
+	// the `s3-read-only` preset.
+	Presets []string `pulumi:"presets"`
 }
 
-// NetworkingInput is an input type that accepts NetworkingArgs and NetworkingOutput values.
-// You can construct a concrete instance of `NetworkingInput` via:
+// TaskRoleInput is an input type that accepts TaskRoleArgs and TaskRoleOutput values.
+// You can construct a concrete instance of `TaskRoleInput` via:
 //
-//          NetworkingArgs{...}
-type NetworkingInput interface {
+//	TaskRoleArgs{...}
+type TaskRoleInput interface {
 	pulumi.Input
 
-	ToNetworkingOutput() NetworkingOutput
-	ToNetworkingOutputWithContext(context.Context) NetworkingOutput
+	ToTaskRoleOutput() TaskRoleOutput
+	ToTaskRoleOutputWithContext(context.Context) TaskRoleOutput
 }
 
-// The options for networking.
-type NetworkingArgs struct {
-	// The subnets to use for the RDS instance.
-	DbSubnetIds pulumi.StringArrayInput `pulumi:"dbSubnetIds"`
-	// The subnets to use for the Fargate task.
-	EcsSubnetIds pulumi.StringArrayInput `pulumi:"ecsSubnetIds"`
-	// The subnets to use for the load balancer.
-	LbSubnetIds pulumi.StringArrayInput `pulumi:"lbSubnetIds"`
+// Options for the IAM role assumed by the Metabase container. Use it to let Metabase connect to data
+// sources such as Athena, S3 or Redshift with IAM credentials.
+type TaskRoleArgs struct {
+	// The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+	AthenaResultsBucket pulumi.StringPtrInput `pulumi:"athenaResultsBucket"`
+	// Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+	InlinePolicies pulumi.StringMapInput `pulumi:"inlinePolicies"`
+	// The ARNs of IAM managed policies to attach to the task role.
+	ManagedPolicyArns pulumi.StringArrayInput `pulumi:"managedPolicyArns"`
+	// Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+	// which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+	// Access to the S3 buckets backing Athena tables must be granted separately, for example with
+	// the `s3-read-only` preset.
+	Presets []pulumi.StringInput `pulumi:"presets"`
 }
 
-func (NetworkingArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*Networking)(nil)).Elem()
+func (TaskRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*TaskRole)(nil)).Elem()
 }
 
-func (i NetworkingArgs) ToNetworkingOutput() NetworkingOutput {
-	return i.ToNetworkingOutputWithContext(context.Background())
+func (i TaskRoleArgs) ToTaskRoleOutput() TaskRoleOutput {
+	return i.ToTaskRoleOutputWithContext(context.Background())
 }
 
-func (i NetworkingArgs) ToNetworkingOutputWithContext(ctx context.Context) NetworkingOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(NetworkingOutput)
+func (i TaskRoleArgs) ToTaskRoleOutputWithContext(ctx context.Context) TaskRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TaskRoleOutput)
 }
 
-func (i NetworkingArgs) ToNetworkingPtrOutput() NetworkingPtrOutput {
-	return i.ToNetworkingPtrOutputWithContext(context.Background())
+func (i TaskRoleArgs) ToTaskRolePtrOutput() TaskRolePtrOutput {
+	return i.ToTaskRolePtrOutputWithContext(context.Background())
 }
 
-func (i NetworkingArgs) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(NetworkingOutput).ToNetworkingPtrOutputWithContext(ctx)
+func (i TaskRoleArgs) ToTaskRolePtrOutputWithContext(ctx context.Context) TaskRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TaskRoleOutput).ToTaskRolePtrOutputWithContext(ctx)
 }
 
-// NetworkingPtrInput is an input type that accepts NetworkingArgs, NetworkingPtr and NetworkingPtrOutput values.
-// You can construct a concrete instance of `NetworkingPtrInput` via:
+// TaskRolePtrInput is an input type that accepts TaskRoleArgs, TaskRolePtr and TaskRolePtrOutput values.
+// You can construct a concrete instance of `TaskRolePtrInput` via:
 //
-//          NetworkingArgs{...}
+//	        TaskRoleArgs{...}
 //
-//  or:
+//	or:
 //
-//          nil
-type NetworkingPtrInput interface {
+//	        nil
+type TaskRolePtrInput interface {
 	pulumi.Input
 
-	ToNetworkingPtrOutput() NetworkingPtrOutput
-	ToNetworkingPtrOutputWithContext(context.Context) NetworkingPtrOutput
+	ToTaskRolePtrOutput() TaskRolePtrOutput
+	ToTaskRolePtrOutputWithContext(context.Context) TaskRolePtrOutput
 }
 
-type networkingPtrType NetworkingArgs
+type taskRolePtrType TaskRoleArgs
 
-func NetworkingPtr(v *NetworkingArgs) NetworkingPtrInput {
-	return (*networkingPtrType)(v)
+func TaskRolePtr(v *TaskRoleArgs) TaskRolePtrInput {
+	return (*taskRolePtrType)(v)
 }
 
-func (*networkingPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**Networking)(nil)).Elem()
+func (*taskRolePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**TaskRole)(nil)).Elem()
 }
 
-func (i *networkingPtrType) ToNetworkingPtrOutput() NetworkingPtrOutput {
-	return i.ToNetworkingPtrOutputWithContext(context.Background())
+func (i *taskRolePtrType) ToTaskRolePtrOutput() TaskRolePtrOutput {
+	return i.ToTaskRolePtrOutputWithContext(context.Background())
 }
 
-func (i *networkingPtrType) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(NetworkingPtrOutput)
+func (i *taskRolePtrType) ToTaskRolePtrOutputWithContext(ctx context.Context) TaskRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TaskRolePtrOutput)
 }
 
-// The options for networking.
-type NetworkingOutput struct{ *pulumi.OutputState }
+// Options for the IAM role assumed by the Metabase container. Use it to let Metabase connect to data
+// sources such as Athena, S3 or Redshift with IAM credentials.
+type TaskRoleOutput struct{ *pulumi.OutputState }
 
-func (NetworkingOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*Networking)(nil)).Elem()
+func (TaskRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TaskRole)(nil)).Elem()
 }
 
-func (o NetworkingOutput) ToNetworkingOutput() NetworkingOutput {
+func (o TaskRoleOutput) ToTaskRoleOutput() TaskRoleOutput {
 	return o
 }
 
-func (o NetworkingOutput) ToNetworkingOutputWithContext(ctx context.Context) NetworkingOutput {
+func (o TaskRoleOutput) ToTaskRoleOutputWithContext(ctx context.Context) TaskRoleOutput {
 	return o
 }
 
-func (o NetworkingOutput) ToNetworkingPtrOutput() NetworkingPtrOutput {
-	return o.ToNetworkingPtrOutputWithContext(context.Background())
+func (o TaskRoleOutput) ToTaskRolePtrOutput() TaskRolePtrOutput {
+	return o.ToTaskRolePtrOutputWithContext(context.Background())
 }
 
-func (o NetworkingOutput) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Networking) *Networking {
+func (o TaskRoleOutput) ToTaskRolePtrOutputWithContext(ctx context.Context) TaskRolePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v TaskRole) *TaskRole {
 		return &v
-	}).(NetworkingPtrOutput)
+	}).(TaskRolePtrOutput)
 }
 
-// The subnets to use for the RDS instance.
-func (o NetworkingOutput) DbSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v Networking) []string { return v.DbSubnetIds }).(pulumi.StringArrayOutput)
+// The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+func (o TaskRoleOutput) AthenaResultsBucket() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TaskRole) *string { return v.AthenaResultsBucket }).(pulumi.StringPtrOutput)
 }
 
-// The subnets to use for the Fargate task.
-func (o NetworkingOutput) EcsSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v Networking) []string { return v.EcsSubnetIds }).(pulumi.StringArrayOutput)
+// Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+func (o TaskRoleOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v TaskRole) map[string]string { return v.InlinePolicies }).(pulumi.StringMapOutput)
 }
 
-// The subnets to use for the load balancer.
-func (o NetworkingOutput) LbSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v Networking) []string { return v.LbSubnetIds }).(pulumi.StringArrayOutput)
+// The ARNs of IAM managed policies to attach to the task role.
+func (o TaskRoleOutput) ManagedPolicyArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v TaskRole) []string { return v.ManagedPolicyArns }).(pulumi.StringArrayOutput)
 }
 
-type NetworkingPtrOutput struct{ *pulumi.OutputState }
+// Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+// which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+// Access to the S3 buckets backing Athena tables must be granted separately, for example with
+// the `s3-read-only` preset.
+func (o TaskRoleOutput) Presets() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v TaskRole) []string { return v.Presets }).(pulumi.StringArrayOutput)
+}
 
-func (NetworkingPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Networking)(nil)).Elem()
+type TaskRolePtrOutput struct{ *pulumi.OutputState }
+
+func (TaskRolePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**TaskRole)(nil)).Elem()
 }
 
-func (o NetworkingPtrOutput) ToNetworkingPtrOutput() NetworkingPtrOutput {
+func (o TaskRolePtrOutput) ToTaskRolePtrOutput() TaskRolePtrOutput {
 	return o
 }
 
-func (o NetworkingPtrOutput) ToNetworkingPtrOutputWithContext(ctx context.Context) NetworkingPtrOutput {
+func (o TaskRolePtrOutput) ToTaskRolePtrOutputWithContext(ctx context.Context) TaskRolePtrOutput {
 	return o
 }
 
-func (o NetworkingPtrOutput) Elem() NetworkingOutput {
-	return o.ApplyT(func(v *Networking) Networking {
+func (o TaskRolePtrOutput) Elem() TaskRoleOutput {
+	return o.ApplyT(func(v *TaskRole) TaskRole {
 		if v != nil {
 			return *v
 		}
-		var ret Networking
+		var ret TaskRole
 		return ret
-	}).(NetworkingOutput)
+	}).(TaskRoleOutput)
 }
 
-// The subnets to use for the RDS instance.
-func (o NetworkingPtrOutput) DbSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *Networking) []string {
+// The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+func (o TaskRolePtrOutput) AthenaResultsBucket() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *TaskRole) *string {
 		if v == nil {
 			return nil
 		}
-		return v.DbSubnetIds
-	}).(pulumi.StringArrayOutput)
+		return v.AthenaResultsBucket
+	}).(pulumi.StringPtrOutput)
 }
 
-// The subnets to use for the Fargate task.
-func (o NetworkingPtrOutput) EcsSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *Networking) []string {
+// Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+func (o TaskRolePtrOutput) InlinePolicies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *TaskRole) map[string]string {
 		if v == nil {
 			return nil
 		}
-		return v.EcsSubnetIds
+		return v.InlinePolicies
+	}).(pulumi.StringMapOutput)
+}
+
+// The ARNs of IAM managed policies to attach to the task role.
+func (o TaskRolePtrOutput) ManagedPolicyArns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *TaskRole) []string {
+		if v == nil {
+			return nil
+		}
+		return v.ManagedPolicyArns
 	}).(pulumi.StringArrayOutput)
 }
 
-// The subnets to use for the load balancer.
-func (o NetworkingPtrOutput) LbSubnetIds() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *Networking) []string {
+// Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+// which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+// Access to the S3 buckets backing Athena tables must be granted separately, for example with
+// the `s3-read-only` preset.
+func (o TaskRolePtrOutput) Presets() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *TaskRole) []string {
 		if v == nil {
 			return nil
 		}
-		return v.LbSubnetIds
+		return v.Presets
 	}).(pulumi.StringArrayOutput)
 }
 
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*CapacityProviderStrategyInput)(nil)).Elem(), CapacityProviderStrategyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CapacityProviderStrategyArrayInput)(nil)).Elem(), CapacityProviderStrategyArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ClusterInput)(nil)).Elem(), ClusterArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ClusterPtrInput)(nil)).Elem(), ClusterArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ComputeInput)(nil)).Elem(), ComputeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ComputePtrInput)(nil)).Elem(), ComputeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerDependencyInput)(nil)).Elem(), ContainerDependencyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ContainerDependencyArrayInput)(nil)).Elem(), ContainerDependencyArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CustomDomainInput)(nil)).Elem(), CustomDomainArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CustomDomainPtrInput)(nil)).Elem(), CustomDomainArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseInput)(nil)).Elem(), DatabaseArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*DatabasePtrInput)(nil)).Elem(), DatabaseArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ExecuteCommandInput)(nil)).Elem(), ExecuteCommandArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ExecuteCommandPtrInput)(nil)).Elem(), ExecuteCommandArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FireLensInput)(nil)).Elem(), FireLensArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FireLensPtrInput)(nil)).Elem(), FireLensArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HealthCheckInput)(nil)).Elem(), HealthCheckArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HealthCheckPtrInput)(nil)).Elem(), HealthCheckArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ImageMirrorInput)(nil)).Elem(), ImageMirrorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ImageMirrorPtrInput)(nil)).Elem(), ImageMirrorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LoggingInput)(nil)).Elem(), LoggingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LoggingPtrInput)(nil)).Elem(), LoggingArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NetworkingInput)(nil)).Elem(), NetworkingArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NetworkingPtrInput)(nil)).Elem(), NetworkingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PluginInput)(nil)).Elem(), PluginArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ScheduleInput)(nil)).Elem(), ScheduleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SchedulePtrInput)(nil)).Elem(), ScheduleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SettingsInput)(nil)).Elem(), SettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SettingsPtrInput)(nil)).Elem(), SettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SidecarInput)(nil)).Elem(), SidecarArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SidecarHealthCheckInput)(nil)).Elem(), SidecarHealthCheckArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SidecarHealthCheckPtrInput)(nil)).Elem(), SidecarHealthCheckArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SidecarPortMappingInput)(nil)).Elem(), SidecarPortMappingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SidecarPortMappingArrayInput)(nil)).Elem(), SidecarPortMappingArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TaskRoleInput)(nil)).Elem(), TaskRoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TaskRolePtrInput)(nil)).Elem(), TaskRoleArgs{})
+	pulumi.RegisterOutputType(CapacityProviderStrategyOutput{})
+	pulumi.RegisterOutputType(CapacityProviderStrategyArrayOutput{})
+	pulumi.RegisterOutputType(ClusterOutput{})
+	pulumi.RegisterOutputType(ClusterPtrOutput{})
+	pulumi.RegisterOutputType(ComputeOutput{})
+	pulumi.RegisterOutputType(ComputePtrOutput{})
+	pulumi.RegisterOutputType(ContainerDependencyOutput{})
+	pulumi.RegisterOutputType(ContainerDependencyArrayOutput{})
 	pulumi.RegisterOutputType(CustomDomainOutput{})
 	pulumi.RegisterOutputType(CustomDomainPtrOutput{})
 	pulumi.RegisterOutputType(DatabaseOutput{})
 	pulumi.RegisterOutputType(DatabasePtrOutput{})
+	pulumi.RegisterOutputType(ExecuteCommandOutput{})
+	pulumi.RegisterOutputType(ExecuteCommandPtrOutput{})
+	pulumi.RegisterOutputType(FireLensOutput{})
+	pulumi.RegisterOutputType(FireLensPtrOutput{})
+	pulumi.RegisterOutputType(HealthCheckOutput{})
+	pulumi.RegisterOutputType(HealthCheckPtrOutput{})
+	pulumi.RegisterOutputType(ImageMirrorOutput{})
+	pulumi.RegisterOutputType(ImageMirrorPtrOutput{})
+	pulumi.RegisterOutputType(LoggingOutput{})
+	pulumi.RegisterOutputType(LoggingPtrOutput{})
 	pulumi.RegisterOutputType(NetworkingOutput{})
 	pulumi.RegisterOutputType(NetworkingPtrOutput{})
+	pulumi.RegisterOutputType(PluginOutput{})
+	pulumi.RegisterOutputType(ScheduleOutput{})
+	pulumi.RegisterOutputType(SchedulePtrOutput{})
+	pulumi.RegisterOutputType(SettingsOutput{})
+	pulumi.RegisterOutputType(SettingsPtrOutput{})
+	pulumi.RegisterOutputType(SidecarOutput{})
+	pulumi.RegisterOutputType(SidecarHealthCheckOutput{})
+	pulumi.RegisterOutputType(SidecarHealthCheckPtrOutput{})
+	pulumi.RegisterOutputType(SidecarPortMappingOutput{})
+	pulumi.RegisterOutputType(SidecarPortMappingArrayOutput{})
+	pulumi.RegisterOutputType(TaskRoleOutput{})
+	pulumi.RegisterOutputType(TaskRolePtrOutput{})
 }
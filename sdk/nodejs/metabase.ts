@@ -74,10 +74,26 @@ export class Metabase extends pulumi.ComponentResource {
      * The DNS name for the Metabase instance.
      */
     public /*out*/ readonly dnsName!: pulumi.Output<string>;
+    /**
+     * The digest the Metabase image is pinned to, empty when the image isn't pinned.
+     */
+    public /*out*/ readonly imageDigest!: pulumi.Output<string>;
+    /**
+     * The repository the Metabase image is pulled from.
+     */
+    public /*out*/ readonly imageRepository!: pulumi.Output<string>;
+    /**
+     * The name of the CloudWatch log group the Metabase container logs are sent to.
+     */
+    public /*out*/ readonly logGroupName!: pulumi.Output<string>;
     /**
      * The security group id for the Metabase instance.
      */
     public /*out*/ readonly securityGroupId!: pulumi.Output<string>;
+    /**
+     * The ARN of the IAM role assumed by the Metabase container.
+     */
+    public /*out*/ readonly taskRoleArn!: pulumi.Output<string>;
 
     /**
      * Create a Metabase resource with the given unique name, arguments, and options.
@@ -90,16 +106,46 @@ export class Metabase extends pulumi.ComponentResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            resourceInputs["database"] = args ? (args.database ? pulumi.output(args.database).apply(inputs.databaseArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["allowDowngrade"] = args ? args.allowDowngrade : undefined;
+            resourceInputs["cluster"] = args ? args.cluster : undefined;
+            resourceInputs["compute"] = args ? args.compute : undefined;
+            resourceInputs["database"] = args ? args.database : undefined;
             resourceInputs["domain"] = args ? args.domain : undefined;
+            resourceInputs["ecsClusterArn"] = args ? args.ecsClusterArn : undefined;
+            resourceInputs["edition"] = (args ? args.edition : undefined) ?? "oss";
+            resourceInputs["enableExecuteCommand"] = args ? args.enableExecuteCommand : undefined;
+            resourceInputs["environment"] = args ? args.environment : undefined;
+            resourceInputs["executeCommand"] = args ? args.executeCommand : undefined;
+            resourceInputs["hardened"] = args ? args.hardened : undefined;
+            resourceInputs["healthCheck"] = args ? (args.healthCheck ? pulumi.output(args.healthCheck).apply(inputs.healthCheckArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["image"] = args ? args.image : undefined;
+            resourceInputs["imageMirror"] = args ? (args.imageMirror ? pulumi.output(args.imageMirror).apply(inputs.imageMirrorArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["logging"] = args ? (args.logging ? pulumi.output(args.logging).apply(inputs.loggingArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["metabaseVersion"] = args ? args.metabaseVersion : undefined;
             resourceInputs["networking"] = args ? args.networking : undefined;
+            resourceInputs["pinImageDigest"] = (args ? args.pinImageDigest : undefined) ?? true;
+            resourceInputs["plugins"] = args ? args.plugins : undefined;
+            resourceInputs["premiumEmbeddingToken"] = args?.premiumEmbeddingToken ? pulumi.secret(args.premiumEmbeddingToken) : undefined;
+            resourceInputs["repositoryCredentials"] = args ? args.repositoryCredentials : undefined;
+            resourceInputs["schedule"] = args ? args.schedule : undefined;
+            resourceInputs["secrets"] = args ? args.secrets : undefined;
+            resourceInputs["settings"] = args ? args.settings : undefined;
+            resourceInputs["sidecars"] = args ? args.sidecars : undefined;
+            resourceInputs["taskRole"] = args ? args.taskRole : undefined;
             resourceInputs["vpcId"] = args ? args.vpcId : undefined;
             resourceInputs["dnsName"] = undefined /*out*/;
+            resourceInputs["imageDigest"] = undefined /*out*/;
+            resourceInputs["imageRepository"] = undefined /*out*/;
+            resourceInputs["logGroupName"] = undefined /*out*/;
             resourceInputs["securityGroupId"] = undefined /*out*/;
+            resourceInputs["taskRoleArn"] = undefined /*out*/;
         } else {
             resourceInputs["dnsName"] = undefined /*out*/;
+            resourceInputs["imageDigest"] = undefined /*out*/;
+            resourceInputs["imageRepository"] = undefined /*out*/;
+            resourceInputs["logGroupName"] = undefined /*out*/;
             resourceInputs["securityGroupId"] = undefined /*out*/;
+            resourceInputs["taskRoleArn"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Metabase.__pulumiType, name, resourceInputs, opts, true /*remote*/);
@@ -110,6 +156,23 @@ export class Metabase extends pulumi.ComponentResource {
  * The set of arguments for constructing a Metabase resource.
  */
 export interface MetabaseArgs {
+    /**
+     * Metabase's database migrations only run forwards, so deploying an older `metabaseVersion` than the one
+     * last deployed is refused. The deployed version is recorded in the SSM parameter
+     * `/pulumi/metabase/<project>/<stack>/<name>/version`. A deployed tag that doesn't name a release, such as
+     * `latest`, can't be compared, so moving from it to a version only logs a warning. Set this to deploy an older
+     * version anyway, typically after restoring a database snapshot taken before the upgrade.
+     */
+    allowDowngrade?: boolean;
+    /**
+     * Optionally configure the ECS cluster created for Metabase, such as enabling Container Insights. Can't be
+     * used with `ecsClusterArn`.
+     */
+    cluster?: pulumi.Input<inputs.ClusterArgs>;
+    /**
+     * Optionally configure the Fargate compute Metabase runs on.
+     */
+    compute?: pulumi.Input<inputs.ComputeArgs>;
     /**
      * Optional arguments for configuring your RDS instance.
      */
@@ -119,13 +182,126 @@ export interface MetabaseArgs {
      */
     domain?: pulumi.Input<inputs.CustomDomainArgs>;
     /**
-     * The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image.
+     * The ARN of an existing ECS cluster to run the Metabase service on instead of creating one, which can be
+     * the output of another stack. The cluster must be `ACTIVE`, which is checked once the ARN is known. Its
+     * Container Insights, capacity provider and ECS Exec settings are left untouched, so the service runs with
+     * the `FARGATE` launch type and `compute.capacity`, `cluster` and `executeCommand` can't be used.
+     */
+    ecsClusterArn?: pulumi.Input<string>;
+    /**
+     * The Metabase edition to run, either `oss` or `enterprise`.
+     */
+    edition?: string;
+    /**
+     * Whether to enable ECS Exec, which lets you open a shell in the Metabase container with
+     * `aws ecs execute-command`. The task role is given the SSM permissions the sessions need.
+     */
+    enableExecuteCommand?: boolean;
+    /**
+     * Additional environment variables for the Metabase container, for example any of the `MB_*`
+     * [settings](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables). The
+     * `MB_DB_*` variables are managed by the component and can't be set. The variable names must be known
+     * before deployment, the values can be outputs of other resources.
+     */
+    environment?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Optionally encrypt and log ECS Exec sessions. Requires `enableExecuteCommand`.
+     */
+    executeCommand?: pulumi.Input<inputs.ExecuteCommandArgs>;
+    /**
+     * Whether to harden the Metabase container. It runs as the non-root `metabase` user (uid 2000) with a
+     * read-only root filesystem, all Linux capabilities dropped, an open files limit of 65536 and 60 seconds
+     * to shut down. Fargate doesn't support tmpfs, so the paths Metabase writes to, `/tmp` and the plugins
+     * directory, are stored on an EFS file system with a mount target in each of the ECS subnets, which must
+     * be in different availability zones and known before deployment. Requires an image that runs as the
+     * `metabase` user such as the official images, and can't be used with `enableExecuteCommand`. The FireLens
+     * and sidecar containers aren't changed. Defaults to `false`.
+     */
+    hardened?: boolean;
+    /**
+     * Optionally tune the health checks run against the Metabase container.
+     */
+    healthCheck?: pulumi.Input<inputs.HealthCheckArgs>;
+    /**
+     * A full image reference, such as `registry.example.com/metabase:v0.46.6-drivers`, to run instead of the
+     * official Metabase image. The image is used as is, `metabaseVersion` doesn't change its tag. Can't be used
+     * with `imageMirror`. The container health check runs `curl`, which a custom image may not ship, so only
+     * the load balancer health checks a custom image.
+     */
+    image?: pulumi.Input<string>;
+    /**
+     * Optionally mirror the Metabase image in a private ECR registry.
+     */
+    imageMirror?: pulumi.Input<inputs.ImageMirrorArgs>;
+    /**
+     * Optional arguments for configuring the Metabase container logs.
+     */
+    logging?: pulumi.Input<inputs.LoggingArgs>;
+    /**
+     * The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image, or the
+     * `metabase/metabase-enterprise` image for the `enterprise` edition. Open source tags look like `v0.46.6`
+     * and enterprise tags look like `v1.46.6`. When the version changes, a manual snapshot of the application
+     * database named `<name>metabase-<old version>-to-<new version>` is taken before the new version is deployed.
+     * When the image is pinned with `pinImageDigest`, a tag that moved to a new image, such as `latest`, is
+     * snapshotted too and the versions in the name are followed by the first 12 characters of their digests.
+     * Unpinned images aren't snapshotted when their tag moves. The snapshot is kept when the component is updated
+     * again and has to be deleted by hand.
      */
     metabaseVersion?: pulumi.Input<string>;
     /**
      * Optionally provide specific subnet IDs to run the different resources of Metabase.
      */
     networking?: pulumi.Input<inputs.NetworkingArgs>;
+    /**
+     * Whether to resolve the image tag to its `sha256` digest at deploy time and run that digest, so every task
+     * runs the same image and a change to the tag shows up as a diff. Images in a private ECR registry are
+     * looked up with the ECR API, and aren't pinned when the registry is in another region. Images that need
+     * `repositoryCredentials` aren't pinned.
+     */
+    pinImageDigest?: boolean;
+    /**
+     * Plugin JARs to install in Metabase's plugins directory (`MB_PLUGINS_DIR`), such as the ClickHouse or DuckDB
+     * community drivers. The plugins directory is stored on an EFS file system with a mount target in each of
+     * the ECS subnets, which must be in different availability zones and known before deployment. An init
+     * container downloads any missing plugin and checks its checksum before Metabase starts, and removes the
+     * plugins it installed that are no longer listed.
+     */
+    plugins?: pulumi.Input<inputs.PluginArgs>[];
+    /**
+     * The Metabase Enterprise token, passed to the container as `MB_PREMIUM_EMBEDDING_TOKEN` through a
+     * Secrets Manager secret. Requires the `enterprise` edition.
+     */
+    premiumEmbeddingToken?: pulumi.Input<string>;
+    /**
+     * The ARN of a Secrets Manager secret holding the `username` and `password` of the private registry hosting
+     * `image`. The task execution role is granted access to the secret.
+     */
+    repositoryCredentials?: pulumi.Input<string>;
+    /**
+     * Optionally stop Metabase outside working hours.
+     */
+    schedule?: pulumi.Input<inputs.ScheduleArgs>;
+    /**
+     * Additional environment variables for the Metabase container whose values are read from Secrets
+     * Manager or SSM Parameter Store, as a map of variable name to secret ARN. The task execution role is
+     * granted access to these secrets. The `MB_DB_*` variables are managed by the component and can't be set.
+     * The variable names must be known before deployment, the ARNs can be outputs of other resources such as
+     * a secret created in the same program.
+     */
+    secrets?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Optionally configure commonly used Metabase application settings.
+     */
+    settings?: pulumi.Input<inputs.SettingsArgs>;
+    /**
+     * Extra containers to run in the Metabase task. Their logs are sent to the Metabase log group with the
+     * container name as the stream prefix.
+     */
+    sidecars?: pulumi.Input<inputs.SidecarArgs>[];
+    /**
+     * Optionally give the Metabase container IAM permissions.
+     */
+    taskRole?: pulumi.Input<inputs.TaskRoleArgs>;
     /**
      * The VPC to use for the Metabase service. If left blank then the default VPC will be used.
      */
@@ -2,9 +2,85 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
-import { input as inputs, output as outputs } from "../types";
+import { input as inputs, output as outputs } from "./types";
 
-import * as utilities from "../utilities";
+import * as utilities from "./utilities";
+
+/**
+ * An item of a cluster's default capacity provider strategy.
+ */
+export interface CapacityProviderStrategyArgs {
+    /**
+     * The number of tasks to run on the capacity provider before the weights apply. Only one capacity
+     * provider can have a base. Defaults to `0`.
+     */
+    base?: number;
+    /**
+     * The capacity provider, either `FARGATE` or `FARGATE_SPOT`.
+     */
+    capacityProvider: string;
+    /**
+     * The relative share of tasks launched on the capacity provider. Defaults to `0`.
+     */
+    weight?: number;
+}
+
+/**
+ * Settings of the ECS cluster created for Metabase.
+ */
+export interface ClusterArgs {
+    /**
+     * Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+     * storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+     */
+    containerInsights?: boolean;
+    /**
+     * The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+     * of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+     * type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+     * associated with the cluster when this is set.
+     */
+    defaultCapacityProviderStrategy?: pulumi.Input<inputs.CapacityProviderStrategyArgs>[];
+    /**
+     * Tags to apply to the cluster.
+     */
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+
+/**
+ * Options for the Fargate compute the Metabase task runs on.
+ */
+export interface ComputeArgs {
+    /**
+     * The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+     * published for the architecture, which is checked against the registry unless the image needs
+     * `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+     */
+    architecture?: string;
+    /**
+     * Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+     * task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+     * on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+     * non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+     * doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+     * `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+     */
+    capacity?: string;
+}
+
+/**
+ * A container a sidecar waits for before it's started.
+ */
+export interface ContainerDependencyArgs {
+    /**
+     * The condition to wait for, one of `START`, `COMPLETE`, `SUCCESS` or `HEALTHY`.
+     */
+    condition: string;
+    /**
+     * The name of the sidecar to wait for.
+     */
+    containerName: string;
+}
 
 /**
  * Options for setting a custom domain.
@@ -18,22 +94,184 @@ export interface CustomDomainArgs {
  * The options for configuring your database.
  */
 export interface DatabaseArgs {
+    /**
+     * The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+     * file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+     * but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
+     */
+    engine?: string;
     /**
      * The database engine version. Updating this argument results in an outage. See the
      * [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
      * documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
      * a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-     * where supported by the API.
+     * where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+     * which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+     * versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+     * later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+     * Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+     * Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+     * `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
      */
     engineVersion?: pulumi.Input<string>;
+    /**
+     * Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+     * scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+     * Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+     * Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+     * Defaults to `false`.
+     */
+    useProxy?: boolean;
+}
+
+/**
+ * Options for ECS Exec sessions into the Metabase container.
+ */
+export interface ExecuteCommandArgs {
+    /**
+     * The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+     * is allowed to decrypt with the key.
+     */
+    kmsKeyId?: pulumi.Input<string>;
+    /**
+     * The name of a CloudWatch log group to log the session output to.
+     */
+    logGroupName?: pulumi.Input<string>;
+    /**
+     * The name of an S3 bucket to log the session output to.
+     */
+    s3BucketName?: pulumi.Input<string>;
+    /**
+     * The key prefix of the session logs in `s3BucketName`.
+     */
+    s3KeyPrefix?: pulumi.Input<string>;
+}
+
+/**
+ * Options for routing the Metabase container logs with AWS FireLens.
+ */
+export interface FireLensArgs {
+    /**
+     * The Fluent Bit image to run as the log router.
+     */
+    image?: pulumi.Input<string>;
+    /**
+     * The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+     * `Name` of the output plugin is required.
+     */
+    options?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+     * of setting name to secret ARN. The task execution role is granted access to these secrets.
+     */
+    secretOptions?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+/**
+ * fireLensArgsProvideDefaults sets the appropriate defaults for FireLensArgs
+ */
+export function fireLensArgsProvideDefaults(val: FireLensArgs): FireLensArgs {
+    return {
+        ...val,
+        image: (val.image) ?? "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable",
+    };
+}
+
+/**
+ * Options for the health checks run by the load balancer target group and the ECS container
+ * against Metabase's `/api/health` endpoint. The container isn't health checked when running a custom `image`.
+ */
+export interface HealthCheckArgs {
+    /**
+     * The number of consecutive successful health checks required before a task is considered healthy.
+     */
+    healthyThreshold?: number;
+    /**
+     * The approximate number of seconds between health checks. Must be between 5 and 300.
+     */
+    interval?: number;
+    /**
+     * The number of seconds to give Metabase to start and run its database migrations before failed
+     * health checks count against it. Must be between 0 and 300.
+     */
+    startPeriod?: number;
+    /**
+     * The number of seconds to wait for a response before failing a health check. Must be between
+     * 2 and 60 and less than `interval`.
+     */
+    timeout?: number;
+    /**
+     * The number of consecutive failed health checks required before a task is considered unhealthy.
+     */
+    unhealthyThreshold?: number;
+}
+/**
+ * healthCheckArgsProvideDefaults sets the appropriate defaults for HealthCheckArgs
+ */
+export function healthCheckArgsProvideDefaults(val: HealthCheckArgs): HealthCheckArgs {
+    return {
+        ...val,
+        healthyThreshold: (val.healthyThreshold) ?? 2,
+        interval: (val.interval) ?? 30,
+        startPeriod: (val.startPeriod) ?? 300,
+        timeout: (val.timeout) ?? 5,
+        unhealthyThreshold: (val.unhealthyThreshold) ?? 5,
+    };
+}
+
+/**
+ * Options for serving the Metabase image from a private ECR registry instead of Docker Hub.
+ */
+export interface ImageMirrorArgs {
+    /**
+     * Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+     */
+    scanOnPush?: boolean;
+    /**
+     * How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+     * with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+     * it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+     * stack, which deletes its images when the mirror is removed.
+     */
+    type?: string;
+}
+/**
+ * imageMirrorArgsProvideDefaults sets the appropriate defaults for ImageMirrorArgs
+ */
+export function imageMirrorArgsProvideDefaults(val: ImageMirrorArgs): ImageMirrorArgs {
+    return {
+        ...val,
+        scanOnPush: (val.scanOnPush) ?? true,
+    };
+}
+
+/**
+ * Options for shipping the Metabase container logs to CloudWatch Logs.
+ */
+export interface LoggingArgs {
+    /**
+     * Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+     * instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+     */
+    firelens?: pulumi.Input<inputs.FireLensArgs>;
+    /**
+     * The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+     * service principal to use the key.
+     */
+    kmsKeyId?: pulumi.Input<string>;
+    /**
+     * The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+     * 150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+     */
+    retentionInDays?: number;
 }
 /**
- * databaseArgsProvideDefaults sets the appropriate defaults for DatabaseArgs
+ * loggingArgsProvideDefaults sets the appropriate defaults for LoggingArgs
  */
-export function databaseArgsProvideDefaults(val: DatabaseArgs): DatabaseArgs {
+export function loggingArgsProvideDefaults(val: LoggingArgs): LoggingArgs {
     return {
         ...val,
-        engineVersion: (val.engineVersion) ?? "5.7.mysql_aurora.2.08.3",
+        firelens: (val.firelens ? pulumi.output(val.firelens).apply(inputs.fireLensArgsProvideDefaults) : undefined),
+        retentionInDays: (val.retentionInDays) ?? 30,
     };
 }
 
@@ -54,3 +292,192 @@ export interface NetworkingArgs {
      */
     lbSubnetIds?: pulumi.Input<pulumi.Input<string>[]>;
 }
+
+/**
+ * A Metabase plugin JAR, such as a community database driver.
+ */
+export interface PluginArgs {
+    /**
+     * The hex encoded SHA-256 checksum of the JAR. The plugin isn't installed if it doesn't match.
+     */
+    sha256: string;
+    /**
+     * The https URL to download the JAR from.
+     */
+    url: string;
+}
+
+/**
+ * A schedule to stop Metabase outside working hours by scaling the service to zero tasks and back to one.
+ * The Aurora Serverless application database pauses once Metabase has been stopped for five minutes.
+ */
+export interface ScheduleArgs {
+    /**
+     * When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+     * `0 19 ? * MON-FRI *` for 7pm on weekdays.
+     */
+    scaleDown: string;
+    /**
+     * When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+     */
+    scaleUp: string;
+    /**
+     * The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+     */
+    timezone?: string;
+}
+
+/**
+ * Commonly used Metabase application settings. Each setting is passed to Metabase through its `MB_*`
+ * [environment variable](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables)
+ * and can't also be set through `environment` or `secrets`.
+ */
+export interface SettingsArgs {
+    /**
+     * The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+     */
+    adminEmail?: string;
+    /**
+     * Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+     */
+    anonTrackingEnabled?: boolean;
+    /**
+     * Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+     */
+    enableQueryCaching?: boolean;
+    /**
+     * The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+     */
+    passwordComplexity?: string;
+    /**
+     * The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+     */
+    sessionTimeoutMinutes?: number;
+    /**
+     * The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+     */
+    siteLocale?: string;
+    /**
+     * The name used for this Metabase instance (`MB_SITE_NAME`).
+     */
+    siteName?: string;
+    /**
+     * The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+     * custom domain when one is configured, and to the load balancer's DNS name otherwise.
+     */
+    siteUrl?: string;
+    /**
+     * The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+     */
+    timezone?: string;
+}
+
+/**
+ * An extra container run in the Metabase task, such as a monitoring agent or a telemetry collector.
+ */
+export interface SidecarArgs {
+    /**
+     * The other sidecars this container waits for before it's started.
+     */
+    dependsOn?: pulumi.Input<inputs.ContainerDependencyArgs>[];
+    /**
+     * Environment variables for the container.
+     */
+    environment?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Whether the task is stopped when the container exits. Defaults to `true`.
+     */
+    essential?: boolean;
+    /**
+     * A Docker health check for the container, required to depend on it being `HEALTHY`.
+     */
+    healthCheck?: pulumi.Input<inputs.SidecarHealthCheckArgs>;
+    /**
+     * The image to run.
+     */
+    image: pulumi.Input<string>;
+    /**
+     * The condition the container has to reach before Metabase is started, one of `START`, `COMPLETE`,
+     * `SUCCESS` or `HEALTHY`. Metabase doesn't wait for the container when not set.
+     */
+    metabaseDependsOn?: string;
+    /**
+     * The name of the container. `metabase`, `log-router` and `plugins-init` are reserved by the component.
+     */
+    name: string;
+    /**
+     * The ports the container listens on. Port 3000 is used by Metabase.
+     */
+    portMappings?: pulumi.Input<inputs.SidecarPortMappingArgs>[];
+    /**
+     * Environment variables whose values are read from Secrets Manager or SSM Parameter Store, as a map of
+     * variable name to secret ARN. The task execution role is granted access to these secrets.
+     */
+    secrets?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
+
+/**
+ * A Docker health check for a sidecar container.
+ */
+export interface SidecarHealthCheckArgs {
+    /**
+     * The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+     */
+    command: pulumi.Input<string>[];
+    /**
+     * The number of seconds between health checks.
+     */
+    interval?: number;
+    /**
+     * The number of consecutive failures before the container is unhealthy.
+     */
+    retries?: number;
+    /**
+     * The number of seconds to give the container to start before failures count.
+     */
+    startPeriod?: number;
+    /**
+     * The number of seconds to wait for the command to succeed.
+     */
+    timeout?: number;
+}
+
+/**
+ * A port a sidecar container listens on.
+ */
+export interface SidecarPortMappingArgs {
+    /**
+     * The port number.
+     */
+    containerPort: number;
+    /**
+     * Either `tcp` or `udp`. Defaults to `tcp`.
+     */
+    protocol?: string;
+}
+
+/**
+ * Options for the IAM role assumed by the Metabase container. Use it to let Metabase connect to data
+ * sources such as Athena, S3 or Redshift with IAM credentials.
+ */
+export interface TaskRoleArgs {
+    /**
+     * The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+     */
+    athenaResultsBucket?: pulumi.Input<string>;
+    /**
+     * Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+     */
+    inlinePolicies?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The ARNs of IAM managed policies to attach to the task role.
+     */
+    managedPolicyArns?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+     * which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+     * Access to the S3 buckets backing Athena tables must be granted separately, for example with
+     * the `s3-read-only` preset.
+     */
+    presets?: pulumi.Input<string>[];
+}
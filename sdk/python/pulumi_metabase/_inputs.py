@@ -9,11 +9,240 @@ from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
 
 __all__ = [
+    'CapacityProviderStrategyArgs',
+    'ClusterArgs',
+    'ComputeArgs',
+    'ContainerDependencyArgs',
     'CustomDomainArgs',
     'DatabaseArgs',
+    'ExecuteCommandArgs',
+    'FireLensArgs',
+    'HealthCheckArgs',
+    'ImageMirrorArgs',
+    'LoggingArgs',
     'NetworkingArgs',
+    'PluginArgs',
+    'ScheduleArgs',
+    'SettingsArgs',
+    'SidecarHealthCheckArgs',
+    'SidecarPortMappingArgs',
+    'SidecarArgs',
+    'TaskRoleArgs',
 ]
 
+@pulumi.input_type
+class CapacityProviderStrategyArgs:
+    def __init__(__self__, *,
+                 capacity_provider: str,
+                 base: Optional[int] = None,
+                 weight: Optional[int] = None):
+        """
+        An item of a cluster's default capacity provider strategy.
+        :param str capacity_provider: The capacity provider, either `FARGATE` or `FARGATE_SPOT`.
+        :param int base: The number of tasks to run on the capacity provider before the weights apply. Only one capacity
+               provider can have a base. Defaults to `0`.
+        :param int weight: The relative share of tasks launched on the capacity provider. Defaults to `0`.
+        """
+        pulumi.set(__self__, "capacity_provider", capacity_provider)
+        if base is not None:
+            pulumi.set(__self__, "base", base)
+        if weight is not None:
+            pulumi.set(__self__, "weight", weight)
+
+    @property
+    @pulumi.getter(name="capacityProvider")
+    def capacity_provider(self) -> str:
+        """
+        The capacity provider, either `FARGATE` or `FARGATE_SPOT`.
+        """
+        return pulumi.get(self, "capacity_provider")
+
+    @capacity_provider.setter
+    def capacity_provider(self, value: str):
+        pulumi.set(self, "capacity_provider", value)
+
+    @property
+    @pulumi.getter
+    def base(self) -> Optional[int]:
+        """
+        The number of tasks to run on the capacity provider before the weights apply. Only one capacity
+        provider can have a base. Defaults to `0`.
+        """
+        return pulumi.get(self, "base")
+
+    @base.setter
+    def base(self, value: Optional[int]):
+        pulumi.set(self, "base", value)
+
+    @property
+    @pulumi.getter
+    def weight(self) -> Optional[int]:
+        """
+        The relative share of tasks launched on the capacity provider. Defaults to `0`.
+        """
+        return pulumi.get(self, "weight")
+
+    @weight.setter
+    def weight(self, value: Optional[int]):
+        pulumi.set(self, "weight", value)
+
+
+@pulumi.input_type
+class ClusterArgs:
+    def __init__(__self__, *,
+                 container_insights: Optional[bool] = None,
+                 default_capacity_provider_strategy: Optional[Sequence[pulumi.Input['CapacityProviderStrategyArgs']]] = None,
+                 tags: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        Settings of the ECS cluster created for Metabase.
+        :param bool container_insights: Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+               storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+        :param Sequence[pulumi.Input['CapacityProviderStrategyArgs']] default_capacity_provider_strategy: The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+               of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+               type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+               associated with the cluster when this is set.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] tags: Tags to apply to the cluster.
+        """
+        if container_insights is not None:
+            pulumi.set(__self__, "container_insights", container_insights)
+        if default_capacity_provider_strategy is not None:
+            pulumi.set(__self__, "default_capacity_provider_strategy", default_capacity_provider_strategy)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter(name="containerInsights")
+    def container_insights(self) -> Optional[bool]:
+        """
+        Whether to enable CloudWatch Container Insights, which publishes task-level CPU, memory, network and
+        storage metrics. Container Insights metrics are billed as custom metrics. Defaults to the account setting.
+        """
+        return pulumi.get(self, "container_insights")
+
+    @container_insights.setter
+    def container_insights(self, value: Optional[bool]):
+        pulumi.set(self, "container_insights", value)
+
+    @property
+    @pulumi.getter(name="defaultCapacityProviderStrategy")
+    def default_capacity_provider_strategy(self) -> Optional[Sequence[pulumi.Input['CapacityProviderStrategyArgs']]]:
+        """
+        The capacity provider strategy used by tasks started in the cluster without a launch type or strategy
+        of their own, such as one-off tasks run with `aws ecs run-task`. The Metabase service keeps the launch
+        type or strategy chosen by `compute.capacity`. The `FARGATE` and `FARGATE_SPOT` capacity providers are
+        associated with the cluster when this is set.
+        """
+        return pulumi.get(self, "default_capacity_provider_strategy")
+
+    @default_capacity_provider_strategy.setter
+    def default_capacity_provider_strategy(self, value: Optional[Sequence[pulumi.Input['CapacityProviderStrategyArgs']]]):
+        pulumi.set(self, "default_capacity_provider_strategy", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Tags to apply to the cluster.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
+@pulumi.input_type
+class ComputeArgs:
+    def __init__(__self__, *,
+                 architecture: Optional[str] = None,
+                 capacity: Optional[str] = None):
+        """
+        Options for the Fargate compute the Metabase task runs on.
+        :param str architecture: The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+               published for the architecture, which is checked against the registry unless the image needs
+               `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+        :param str capacity: Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+               task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+               on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+               non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+               doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+               `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+        """
+        if architecture is not None:
+            pulumi.set(__self__, "architecture", architecture)
+        if capacity is not None:
+            pulumi.set(__self__, "capacity", capacity)
+
+    @property
+    @pulumi.getter
+    def architecture(self) -> Optional[str]:
+        """
+        The CPU architecture to run Metabase on, either `x86_64` or `arm64` for Graviton. The image must be
+        published for the architecture, which is checked against the registry unless the image needs
+        `repositoryCredentials`. A custom FireLens image must also support it. Defaults to Fargate's `x86_64`.
+        """
+        return pulumi.get(self, "architecture")
+
+    @architecture.setter
+    def architecture(self, value: Optional[str]):
+        pulumi.set(self, "architecture", value)
+
+    @property
+    @pulumi.getter
+    def capacity(self) -> Optional[str]:
+        """
+        Whether to run Metabase on `on-demand` Fargate, on `spot` Fargate Spot, or `mixed`, which keeps the first
+        task on regular Fargate and runs three out of four additional tasks, such as the ones a deployment starts,
+        on Spot. Spot is cheaper but AWS can reclaim the task with two minutes' notice, so it's best suited to
+        non-production stacks. When Spot is used, Metabase is given the full two minutes to shut down. Fargate Spot
+        doesn't run `arm64` tasks, so `spot` and `mixed` can't be used with that architecture. Can't be used with
+        `ecsClusterArn`. Defaults to the `FARGATE` launch type.
+        """
+        return pulumi.get(self, "capacity")
+
+    @capacity.setter
+    def capacity(self, value: Optional[str]):
+        pulumi.set(self, "capacity", value)
+
+
+@pulumi.input_type
+class ContainerDependencyArgs:
+    def __init__(__self__, *,
+                 condition: str,
+                 container_name: str):
+        """
+        A container a sidecar waits for before it's started.
+        :param str condition: The condition to wait for, one of `START`, `COMPLETE`, `SUCCESS` or `HEALTHY`.
+        :param str container_name: The name of the sidecar to wait for.
+        """
+        pulumi.set(__self__, "condition", condition)
+        pulumi.set(__self__, "container_name", container_name)
+
+    @property
+    @pulumi.getter
+    def condition(self) -> str:
+        """
+        The condition to wait for, one of `START`, `COMPLETE`, `SUCCESS` or `HEALTHY`.
+        """
+        return pulumi.get(self, "condition")
+
+    @condition.setter
+    def condition(self, value: str):
+        pulumi.set(self, "condition", value)
+
+    @property
+    @pulumi.getter(name="containerName")
+    def container_name(self) -> str:
+        """
+        The name of the sidecar to wait for.
+        """
+        return pulumi.get(self, "container_name")
+
+    @container_name.setter
+    def container_name(self, value: str):
+        pulumi.set(self, "container_name", value)
+
+
 @pulumi.input_type
 class CustomDomainArgs:
     def __init__(__self__, *,
@@ -49,19 +278,51 @@ class CustomDomainArgs:
 @pulumi.input_type
 class DatabaseArgs:
     def __init__(__self__, *,
-                 engine_version: Optional[pulumi.Input[str]] = None):
+                 engine: Optional[str] = None,
+                 engine_version: Optional[pulumi.Input[str]] = None,
+                 use_proxy: Optional[bool] = None):
         """
         The options for configuring your database.
+        :param str engine: The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+               file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+               but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
         :param pulumi.Input[str] engine_version: The database engine version. Updating this argument results in an outage. See the
                [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
                documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
                a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-               where supported by the API.
+               where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+               which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+               versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+               later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+               Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+               Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+               `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
+        :param bool use_proxy: Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+               scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+               Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+               Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+               Defaults to `false`.
         """
-        if engine_version is None:
-            engine_version = '5.7.mysql_aurora.2.08.3'
+        if engine is not None:
+            pulumi.set(__self__, "engine", engine)
         if engine_version is not None:
             pulumi.set(__self__, "engine_version", engine_version)
+        if use_proxy is not None:
+            pulumi.set(__self__, "use_proxy", use_proxy)
+
+    @property
+    @pulumi.getter
+    def engine(self) -> Optional[str]:
+        """
+        The application database engine, either `mysql` for an Aurora Serverless MySQL cluster or `h2` for an H2
+        file stored on EFS. The `h2` engine skips the RDS cluster, which makes it cheaper and faster to create,
+        but Metabase doesn't support H2 in production so only use it for sandboxes. Defaults to `mysql`.
+        """
+        return pulumi.get(self, "engine")
+
+    @engine.setter
+    def engine(self, value: Optional[str]):
+        pulumi.set(self, "engine", value)
 
     @property
     @pulumi.getter(name="engineVersion")
@@ -71,7 +332,13 @@ class DatabaseArgs:
         [Aurora MySQL](https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/AuroraMySQL.Updates.html)
         documentation for your configured engine to determine this value. For example with Aurora MySQL 2,
         a potential value for this argument is 5.7.mysql_aurora.2.03.2. The value can contain a partial version
-        where supported by the API.
+        where supported by the API. The version must be supported by `metabaseVersion`: Metabase 47 and later,
+        which `latest` points to, no longer support MySQL 5.7. Aurora Serverless v1 only runs MySQL 5.7 compatible
+        versions, so MySQL 8.0 compatible versions, `8.0`, `8.0.mysql_aurora.3` or `8.0.mysql_aurora.3.02.0` and
+        later, run on Aurora Serverless v2, which doesn't pause when a `schedule` stops Metabase. An existing
+        Aurora Serverless v1 cluster isn't replaced, it has to be migrated to Aurora Serverless v2 first.
+        Defaults to `8.0.mysql_aurora.3.04.0` for new clusters, while clusters created with the former
+        `5.7.mysql_aurora.2.08.3` default keep it, and deploying `latest` to them logs a warning rather than failing.
         """
         return pulumi.get(self, "engine_version")
 
@@ -79,6 +346,374 @@ class DatabaseArgs:
     def engine_version(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "engine_version", value)
 
+    @property
+    @pulumi.getter(name="useProxy")
+    def use_proxy(self) -> Optional[bool]:
+        """
+        Whether Metabase connects to the cluster through an RDS Proxy, which pools database connections so
+        scaling out Metabase tasks doesn't exhaust them. The proxy reads the cluster credentials from Secrets
+        Manager with its IAM role. RDS Proxy doesn't support Aurora Serverless v1, so the cluster runs on Aurora
+        Serverless v2, which needs a MySQL 8.0 compatible `engineVersion`. Can only be used with the `mysql` engine.
+        Defaults to `false`.
+        """
+        return pulumi.get(self, "use_proxy")
+
+    @use_proxy.setter
+    def use_proxy(self, value: Optional[bool]):
+        pulumi.set(self, "use_proxy", value)
+
+
+@pulumi.input_type
+class ExecuteCommandArgs:
+    def __init__(__self__, *,
+                 kms_key_id: Optional[pulumi.Input[str]] = None,
+                 log_group_name: Optional[pulumi.Input[str]] = None,
+                 s3_bucket_name: Optional[pulumi.Input[str]] = None,
+                 s3_key_prefix: Optional[pulumi.Input[str]] = None):
+        """
+        Options for ECS Exec sessions into the Metabase container.
+        :param pulumi.Input[str] kms_key_id: The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+               is allowed to decrypt with the key.
+        :param pulumi.Input[str] log_group_name: The name of a CloudWatch log group to log the session output to.
+        :param pulumi.Input[str] s3_bucket_name: The name of an S3 bucket to log the session output to.
+        :param pulumi.Input[str] s3_key_prefix: The key prefix of the session logs in `s3BucketName`.
+        """
+        if kms_key_id is not None:
+            pulumi.set(__self__, "kms_key_id", kms_key_id)
+        if log_group_name is not None:
+            pulumi.set(__self__, "log_group_name", log_group_name)
+        if s3_bucket_name is not None:
+            pulumi.set(__self__, "s3_bucket_name", s3_bucket_name)
+        if s3_key_prefix is not None:
+            pulumi.set(__self__, "s3_key_prefix", s3_key_prefix)
+
+    @property
+    @pulumi.getter(name="kmsKeyId")
+    def kms_key_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of a KMS key used to encrypt the session data between the client and the container. The task role
+        is allowed to decrypt with the key.
+        """
+        return pulumi.get(self, "kms_key_id")
+
+    @kms_key_id.setter
+    def kms_key_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "kms_key_id", value)
+
+    @property
+    @pulumi.getter(name="logGroupName")
+    def log_group_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of a CloudWatch log group to log the session output to.
+        """
+        return pulumi.get(self, "log_group_name")
+
+    @log_group_name.setter
+    def log_group_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "log_group_name", value)
+
+    @property
+    @pulumi.getter(name="s3BucketName")
+    def s3_bucket_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of an S3 bucket to log the session output to.
+        """
+        return pulumi.get(self, "s3_bucket_name")
+
+    @s3_bucket_name.setter
+    def s3_bucket_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "s3_bucket_name", value)
+
+    @property
+    @pulumi.getter(name="s3KeyPrefix")
+    def s3_key_prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        The key prefix of the session logs in `s3BucketName`.
+        """
+        return pulumi.get(self, "s3_key_prefix")
+
+    @s3_key_prefix.setter
+    def s3_key_prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "s3_key_prefix", value)
+
+
+@pulumi.input_type
+class FireLensArgs:
+    def __init__(__self__, *,
+                 image: Optional[pulumi.Input[str]] = None,
+                 options: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 secret_options: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        Options for routing the Metabase container logs with AWS FireLens.
+        :param pulumi.Input[str] image: The Fluent Bit image to run as the log router.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] options: The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+               `Name` of the output plugin is required.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] secret_options: Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+               of setting name to secret ARN. The task execution role is granted access to these secrets.
+        """
+        if image is None:
+            image = 'public.ecr.aws/aws-observability/aws-for-fluent-bit:stable'
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if options is not None:
+            pulumi.set(__self__, "options", options)
+        if secret_options is not None:
+            pulumi.set(__self__, "secret_options", secret_options)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[pulumi.Input[str]]:
+        """
+        The Fluent Bit image to run as the log router.
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter
+    def options(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        The settings passed to the Fluent Bit output plugin, for example `Name`, `Host` and `URI`. The
+        `Name` of the output plugin is required.
+        """
+        return pulumi.get(self, "options")
+
+    @options.setter
+    def options(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "options", value)
+
+    @property
+    @pulumi.getter(name="secretOptions")
+    def secret_options(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Output plugin settings whose values are read from Secrets Manager or SSM Parameter Store, as a map
+        of setting name to secret ARN. The task execution role is granted access to these secrets.
+        """
+        return pulumi.get(self, "secret_options")
+
+    @secret_options.setter
+    def secret_options(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "secret_options", value)
+
+
+@pulumi.input_type
+class HealthCheckArgs:
+    def __init__(__self__, *,
+                 healthy_threshold: Optional[int] = None,
+                 interval: Optional[int] = None,
+                 start_period: Optional[int] = None,
+                 timeout: Optional[int] = None,
+                 unhealthy_threshold: Optional[int] = None):
+        """
+        Options for the health checks run by the load balancer target group and the ECS container
+        against Metabase's `/api/health` endpoint. The container isn't health checked when running a custom `image`.
+
+        :param int healthy_threshold: The number of consecutive successful health checks required before a task is considered healthy.
+        :param int interval: The approximate number of seconds between health checks. Must be between 5 and 300.
+        :param int start_period: The number of seconds to give Metabase to start and run its database migrations before failed
+               health checks count against it. Must be between 0 and 300.
+        :param int timeout: The number of seconds to wait for a response before failing a health check. Must be between
+               2 and 60 and less than `interval`.
+        :param int unhealthy_threshold: The number of consecutive failed health checks required before a task is considered unhealthy.
+        """
+        if healthy_threshold is None:
+            healthy_threshold = 2
+        if healthy_threshold is not None:
+            pulumi.set(__self__, "healthy_threshold", healthy_threshold)
+        if interval is None:
+            interval = 30
+        if interval is not None:
+            pulumi.set(__self__, "interval", interval)
+        if start_period is None:
+            start_period = 300
+        if start_period is not None:
+            pulumi.set(__self__, "start_period", start_period)
+        if timeout is None:
+            timeout = 5
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+        if unhealthy_threshold is None:
+            unhealthy_threshold = 5
+        if unhealthy_threshold is not None:
+            pulumi.set(__self__, "unhealthy_threshold", unhealthy_threshold)
+
+    @property
+    @pulumi.getter(name="healthyThreshold")
+    def healthy_threshold(self) -> Optional[int]:
+        """
+        The number of consecutive successful health checks required before a task is considered healthy.
+        """
+        return pulumi.get(self, "healthy_threshold")
+
+    @healthy_threshold.setter
+    def healthy_threshold(self, value: Optional[int]):
+        pulumi.set(self, "healthy_threshold", value)
+
+    @property
+    @pulumi.getter
+    def interval(self) -> Optional[int]:
+        """
+        The approximate number of seconds between health checks. Must be between 5 and 300.
+        """
+        return pulumi.get(self, "interval")
+
+    @interval.setter
+    def interval(self, value: Optional[int]):
+        pulumi.set(self, "interval", value)
+
+    @property
+    @pulumi.getter(name="startPeriod")
+    def start_period(self) -> Optional[int]:
+        """
+        The number of seconds to give Metabase to start and run its database migrations before failed
+        health checks count against it. Must be between 0 and 300.
+        """
+        return pulumi.get(self, "start_period")
+
+    @start_period.setter
+    def start_period(self, value: Optional[int]):
+        pulumi.set(self, "start_period", value)
+
+    @property
+    @pulumi.getter
+    def timeout(self) -> Optional[int]:
+        """
+        The number of seconds to wait for a response before failing a health check. Must be between
+        2 and 60 and less than `interval`.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: Optional[int]):
+        pulumi.set(self, "timeout", value)
+
+    @property
+    @pulumi.getter(name="unhealthyThreshold")
+    def unhealthy_threshold(self) -> Optional[int]:
+        """
+        The number of consecutive failed health checks required before a task is considered unhealthy.
+        """
+        return pulumi.get(self, "unhealthy_threshold")
+
+    @unhealthy_threshold.setter
+    def unhealthy_threshold(self, value: Optional[int]):
+        pulumi.set(self, "unhealthy_threshold", value)
+
+
+@pulumi.input_type
+class ImageMirrorArgs:
+    def __init__(__self__, *,
+                 scan_on_push: Optional[bool] = None,
+                 type: Optional[str] = None):
+        """
+        Options for serving the Metabase image from a private ECR registry instead of Docker Hub.
+        :param bool scan_on_push: Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+        :param str type: How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+               with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+               it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+               stack, which deletes its images when the mirror is removed.
+        """
+        if scan_on_push is None:
+            scan_on_push = True
+        if scan_on_push is not None:
+            pulumi.set(__self__, "scan_on_push", scan_on_push)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter(name="scanOnPush")
+    def scan_on_push(self) -> Optional[bool]:
+        """
+        Whether images copied to the `repository` mirror are scanned for vulnerabilities.
+        """
+        return pulumi.get(self, "scan_on_push")
+
+    @scan_on_push.setter
+    def scan_on_push(self, value: Optional[bool]):
+        pulumi.set(self, "scan_on_push", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        """
+        How the image is mirrored. `repository`, the only and default option, creates a private ECR repository
+        with image scanning and copies the official Metabase image to it when deploying, before the service pulls
+        it. Images already in the repository aren't copied again. The repository is declared in a CloudFormation
+        stack, which deletes its images when the mirror is removed.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[str]):
+        pulumi.set(self, "type", value)
+
+
+@pulumi.input_type
+class LoggingArgs:
+    def __init__(__self__, *,
+                 firelens: Optional[pulumi.Input['FireLensArgs']] = None,
+                 kms_key_id: Optional[pulumi.Input[str]] = None,
+                 retention_in_days: Optional[int] = None):
+        """
+        Options for shipping the Metabase container logs to CloudWatch Logs.
+        :param pulumi.Input['FireLensArgs'] firelens: Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+               instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+        :param pulumi.Input[str] kms_key_id: The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+               service principal to use the key.
+        :param int retention_in_days: The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+               150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+        """
+        if firelens is not None:
+            pulumi.set(__self__, "firelens", firelens)
+        if kms_key_id is not None:
+            pulumi.set(__self__, "kms_key_id", kms_key_id)
+        if retention_in_days is None:
+            retention_in_days = 30
+        if retention_in_days is not None:
+            pulumi.set(__self__, "retention_in_days", retention_in_days)
+
+    @property
+    @pulumi.getter
+    def firelens(self) -> Optional[pulumi.Input['FireLensArgs']]:
+        """
+        Optionally route the Metabase logs through a Fluent Bit sidecar to a third-party log backend
+        instead of CloudWatch Logs. The log router's own logs are still sent to CloudWatch.
+        """
+        return pulumi.get(self, "firelens")
+
+    @firelens.setter
+    def firelens(self, value: Optional[pulumi.Input['FireLensArgs']]):
+        pulumi.set(self, "firelens", value)
+
+    @property
+    @pulumi.getter(name="kmsKeyId")
+    def kms_key_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of a KMS key used to encrypt the log group. The key policy must allow the CloudWatch Logs
+        service principal to use the key.
+        """
+        return pulumi.get(self, "kms_key_id")
+
+    @kms_key_id.setter
+    def kms_key_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "kms_key_id", value)
+
+    @property
+    @pulumi.getter(name="retentionInDays")
+    def retention_in_days(self) -> Optional[int]:
+        """
+        The number of days to keep the Metabase logs. Possible values are 1, 3, 5, 7, 14, 30, 60, 90, 120,
+        150, 180, 365, 400, 545, 731, 1827, 3653, and 0 to keep the logs forever.
+        """
+        return pulumi.get(self, "retention_in_days")
+
+    @retention_in_days.setter
+    def retention_in_days(self, value: Optional[int]):
+        pulumi.set(self, "retention_in_days", value)
+
 
 @pulumi.input_type
 class NetworkingArgs:
@@ -136,3 +771,616 @@ class NetworkingArgs:
         pulumi.set(self, "lb_subnet_ids", value)
 
 
+@pulumi.input_type
+class PluginArgs:
+    def __init__(__self__, *,
+                 sha256: str,
+                 url: str):
+        """
+        A Metabase plugin JAR, such as a community database driver.
+        :param str sha256: The hex encoded SHA-256 checksum of the JAR. The plugin isn't installed if it doesn't match.
+        :param str url: The https URL to download the JAR from.
+        """
+        pulumi.set(__self__, "sha256", sha256)
+        pulumi.set(__self__, "url", url)
+
+    @property
+    @pulumi.getter
+    def sha256(self) -> str:
+        """
+        The hex encoded SHA-256 checksum of the JAR. The plugin isn't installed if it doesn't match.
+        """
+        return pulumi.get(self, "sha256")
+
+    @sha256.setter
+    def sha256(self, value: str):
+        pulumi.set(self, "sha256", value)
+
+    @property
+    @pulumi.getter
+    def url(self) -> str:
+        """
+        The https URL to download the JAR from.
+        """
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: str):
+        pulumi.set(self, "url", value)
+
+
+@pulumi.input_type
+class ScheduleArgs:
+    def __init__(__self__, *,
+                 scale_down: str,
+                 scale_up: str,
+                 timezone: Optional[str] = None):
+        """
+        A schedule to stop Metabase outside working hours by scaling the service to zero tasks and back to one.
+        The Aurora Serverless application database pauses once Metabase has been stopped for five minutes.
+
+        :param str scale_down: When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+               `0 19 ? * MON-FRI *` for 7pm on weekdays.
+        :param str scale_up: When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+        :param str timezone: The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+        """
+        pulumi.set(__self__, "scale_down", scale_down)
+        pulumi.set(__self__, "scale_up", scale_up)
+        if timezone is not None:
+            pulumi.set(__self__, "timezone", timezone)
+
+    @property
+    @pulumi.getter(name="scaleDown")
+    def scale_down(self) -> str:
+        """
+        When to stop Metabase, as an Application Auto Scaling cron expression with six fields such as
+        `0 19 ? * MON-FRI *` for 7pm on weekdays.
+        """
+        return pulumi.get(self, "scale_down")
+
+    @scale_down.setter
+    def scale_down(self, value: str):
+        pulumi.set(self, "scale_down", value)
+
+    @property
+    @pulumi.getter(name="scaleUp")
+    def scale_up(self) -> str:
+        """
+        When to start Metabase again, as a cron expression such as `0 7 ? * MON-FRI *`.
+        """
+        return pulumi.get(self, "scale_up")
+
+    @scale_up.setter
+    def scale_up(self, value: str):
+        pulumi.set(self, "scale_up", value)
+
+    @property
+    @pulumi.getter
+    def timezone(self) -> Optional[str]:
+        """
+        The IANA timezone the cron expressions are evaluated in. Defaults to `UTC`.
+        """
+        return pulumi.get(self, "timezone")
+
+    @timezone.setter
+    def timezone(self, value: Optional[str]):
+        pulumi.set(self, "timezone", value)
+
+
+@pulumi.input_type
+class SettingsArgs:
+    def __init__(__self__, *,
+                 admin_email: Optional[str] = None,
+                 anon_tracking_enabled: Optional[bool] = None,
+                 enable_query_caching: Optional[bool] = None,
+                 password_complexity: Optional[str] = None,
+                 session_timeout_minutes: Optional[int] = None,
+                 site_locale: Optional[str] = None,
+                 site_name: Optional[str] = None,
+                 site_url: Optional[str] = None,
+                 timezone: Optional[str] = None):
+        """
+        Commonly used Metabase application settings. Each setting is passed to Metabase through its `MB_*`
+        [environment variable](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables)
+        and can't also be set through `environment` or `secrets`.
+
+        :param str admin_email: The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+        :param bool anon_tracking_enabled: Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+        :param bool enable_query_caching: Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+        :param str password_complexity: The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+        :param int session_timeout_minutes: The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+        :param str site_locale: The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+        :param str site_name: The name used for this Metabase instance (`MB_SITE_NAME`).
+        :param str site_url: The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+               custom domain when one is configured, and to the load balancer's DNS name otherwise.
+        :param str timezone: The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+        """
+        if admin_email is not None:
+            pulumi.set(__self__, "admin_email", admin_email)
+        if anon_tracking_enabled is not None:
+            pulumi.set(__self__, "anon_tracking_enabled", anon_tracking_enabled)
+        if enable_query_caching is not None:
+            pulumi.set(__self__, "enable_query_caching", enable_query_caching)
+        if password_complexity is not None:
+            pulumi.set(__self__, "password_complexity", password_complexity)
+        if session_timeout_minutes is not None:
+            pulumi.set(__self__, "session_timeout_minutes", session_timeout_minutes)
+        if site_locale is not None:
+            pulumi.set(__self__, "site_locale", site_locale)
+        if site_name is not None:
+            pulumi.set(__self__, "site_name", site_name)
+        if site_url is not None:
+            pulumi.set(__self__, "site_url", site_url)
+        if timezone is not None:
+            pulumi.set(__self__, "timezone", timezone)
+
+    @property
+    @pulumi.getter(name="adminEmail")
+    def admin_email(self) -> Optional[str]:
+        """
+        The email address users are told to contact for help (`MB_ADMIN_EMAIL`).
+        """
+        return pulumi.get(self, "admin_email")
+
+    @admin_email.setter
+    def admin_email(self, value: Optional[str]):
+        pulumi.set(self, "admin_email", value)
+
+    @property
+    @pulumi.getter(name="anonTrackingEnabled")
+    def anon_tracking_enabled(self) -> Optional[bool]:
+        """
+        Whether Metabase sends anonymous usage data to Metabase Inc. (`MB_ANON_TRACKING_ENABLED`).
+        """
+        return pulumi.get(self, "anon_tracking_enabled")
+
+    @anon_tracking_enabled.setter
+    def anon_tracking_enabled(self, value: Optional[bool]):
+        pulumi.set(self, "anon_tracking_enabled", value)
+
+    @property
+    @pulumi.getter(name="enableQueryCaching")
+    def enable_query_caching(self) -> Optional[bool]:
+        """
+        Whether Metabase caches the results of long running queries (`MB_ENABLE_QUERY_CACHING`).
+        """
+        return pulumi.get(self, "enable_query_caching")
+
+    @enable_query_caching.setter
+    def enable_query_caching(self, value: Optional[bool]):
+        pulumi.set(self, "enable_query_caching", value)
+
+    @property
+    @pulumi.getter(name="passwordComplexity")
+    def password_complexity(self) -> Optional[str]:
+        """
+        The password complexity required for users, one of `weak`, `normal` or `strong` (`MB_PASSWORD_COMPLEXITY`).
+        """
+        return pulumi.get(self, "password_complexity")
+
+    @password_complexity.setter
+    def password_complexity(self, value: Optional[str]):
+        pulumi.set(self, "password_complexity", value)
+
+    @property
+    @pulumi.getter(name="sessionTimeoutMinutes")
+    def session_timeout_minutes(self) -> Optional[int]:
+        """
+        The number of minutes of inactivity after which users are logged out (`MB_SESSION_TIMEOUT`).
+        """
+        return pulumi.get(self, "session_timeout_minutes")
+
+    @session_timeout_minutes.setter
+    def session_timeout_minutes(self, value: Optional[int]):
+        pulumi.set(self, "session_timeout_minutes", value)
+
+    @property
+    @pulumi.getter(name="siteLocale")
+    def site_locale(self) -> Optional[str]:
+        """
+        The default language of the Metabase instance, for example `en` or `pt_BR` (`MB_SITE_LOCALE`).
+        """
+        return pulumi.get(self, "site_locale")
+
+    @site_locale.setter
+    def site_locale(self, value: Optional[str]):
+        pulumi.set(self, "site_locale", value)
+
+    @property
+    @pulumi.getter(name="siteName")
+    def site_name(self) -> Optional[str]:
+        """
+        The name used for this Metabase instance (`MB_SITE_NAME`).
+        """
+        return pulumi.get(self, "site_name")
+
+    @site_name.setter
+    def site_name(self, value: Optional[str]):
+        pulumi.set(self, "site_name", value)
+
+    @property
+    @pulumi.getter(name="siteUrl")
+    def site_url(self) -> Optional[str]:
+        """
+        The absolute URL users use to reach Metabase, used in emails and links (`MB_SITE_URL`). Defaults to the
+        custom domain when one is configured, and to the load balancer's DNS name otherwise.
+        """
+        return pulumi.get(self, "site_url")
+
+    @site_url.setter
+    def site_url(self, value: Optional[str]):
+        pulumi.set(self, "site_url", value)
+
+    @property
+    @pulumi.getter
+    def timezone(self) -> Optional[str]:
+        """
+        The IANA timezone of the Metabase JVM (`JAVA_TIMEZONE`). Defaults to `US/Pacific`.
+        """
+        return pulumi.get(self, "timezone")
+
+    @timezone.setter
+    def timezone(self, value: Optional[str]):
+        pulumi.set(self, "timezone", value)
+
+
+@pulumi.input_type
+class SidecarHealthCheckArgs:
+    def __init__(__self__, *,
+                 command: Sequence[pulumi.Input[str]],
+                 interval: Optional[int] = None,
+                 retries: Optional[int] = None,
+                 start_period: Optional[int] = None,
+                 timeout: Optional[int] = None):
+        """
+        A Docker health check for a sidecar container.
+        :param Sequence[pulumi.Input[str]] command: The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+        :param int interval: The number of seconds between health checks.
+        :param int retries: The number of consecutive failures before the container is unhealthy.
+        :param int start_period: The number of seconds to give the container to start before failures count.
+        :param int timeout: The number of seconds to wait for the command to succeed.
+        """
+        pulumi.set(__self__, "command", command)
+        if interval is not None:
+            pulumi.set(__self__, "interval", interval)
+        if retries is not None:
+            pulumi.set(__self__, "retries", retries)
+        if start_period is not None:
+            pulumi.set(__self__, "start_period", start_period)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+
+    @property
+    @pulumi.getter
+    def command(self) -> Sequence[pulumi.Input[str]]:
+        """
+        The command to run, for example `["CMD-SHELL", "curl -f http://localhost:8126/info || exit 1"]`.
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: Sequence[pulumi.Input[str]]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter
+    def interval(self) -> Optional[int]:
+        """
+        The number of seconds between health checks.
+        """
+        return pulumi.get(self, "interval")
+
+    @interval.setter
+    def interval(self, value: Optional[int]):
+        pulumi.set(self, "interval", value)
+
+    @property
+    @pulumi.getter
+    def retries(self) -> Optional[int]:
+        """
+        The number of consecutive failures before the container is unhealthy.
+        """
+        return pulumi.get(self, "retries")
+
+    @retries.setter
+    def retries(self, value: Optional[int]):
+        pulumi.set(self, "retries", value)
+
+    @property
+    @pulumi.getter(name="startPeriod")
+    def start_period(self) -> Optional[int]:
+        """
+        The number of seconds to give the container to start before failures count.
+        """
+        return pulumi.get(self, "start_period")
+
+    @start_period.setter
+    def start_period(self, value: Optional[int]):
+        pulumi.set(self, "start_period", value)
+
+    @property
+    @pulumi.getter
+    def timeout(self) -> Optional[int]:
+        """
+        The number of seconds to wait for the command to succeed.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: Optional[int]):
+        pulumi.set(self, "timeout", value)
+
+
+@pulumi.input_type
+class SidecarPortMappingArgs:
+    def __init__(__self__, *,
+                 container_port: int,
+                 protocol: Optional[str] = None):
+        """
+        A port a sidecar container listens on.
+        :param int container_port: The port number.
+        :param str protocol: Either `tcp` or `udp`. Defaults to `tcp`.
+        """
+        pulumi.set(__self__, "container_port", container_port)
+        if protocol is not None:
+            pulumi.set(__self__, "protocol", protocol)
+
+    @property
+    @pulumi.getter(name="containerPort")
+    def container_port(self) -> int:
+        """
+        The port number.
+        """
+        return pulumi.get(self, "container_port")
+
+    @container_port.setter
+    def container_port(self, value: int):
+        pulumi.set(self, "container_port", value)
+
+    @property
+    @pulumi.getter
+    def protocol(self) -> Optional[str]:
+        """
+        Either `tcp` or `udp`. Defaults to `tcp`.
+        """
+        return pulumi.get(self, "protocol")
+
+    @protocol.setter
+    def protocol(self, value: Optional[str]):
+        pulumi.set(self, "protocol", value)
+
+
+@pulumi.input_type
+class SidecarArgs:
+    def __init__(__self__, *,
+                 image: pulumi.Input[str],
+                 name: str,
+                 depends_on: Optional[Sequence[pulumi.Input['ContainerDependencyArgs']]] = None,
+                 environment: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 essential: Optional[bool] = None,
+                 health_check: Optional[pulumi.Input['SidecarHealthCheckArgs']] = None,
+                 metabase_depends_on: Optional[str] = None,
+                 port_mappings: Optional[Sequence[pulumi.Input['SidecarPortMappingArgs']]] = None,
+                 secrets: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None):
+        """
+        An extra container run in the Metabase task, such as a monitoring agent or a telemetry collector.
+        :param pulumi.Input[str] image: The image to run.
+        :param str name: The name of the container. `metabase`, `log-router` and `plugins-init` are reserved by the component.
+        :param Sequence[pulumi.Input['ContainerDependencyArgs']] depends_on: The other sidecars this container waits for before it's started.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] environment: Environment variables for the container.
+        :param bool essential: Whether the task is stopped when the container exits. Defaults to `true`.
+        :param pulumi.Input['SidecarHealthCheckArgs'] health_check: A Docker health check for the container, required to depend on it being `HEALTHY`.
+        :param str metabase_depends_on: The condition the container has to reach before Metabase is started, one of `START`, `COMPLETE`,
+               `SUCCESS` or `HEALTHY`. Metabase doesn't wait for the container when not set.
+        :param Sequence[pulumi.Input['SidecarPortMappingArgs']] port_mappings: The ports the container listens on. Port 3000 is used by Metabase.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] secrets: Environment variables whose values are read from Secrets Manager or SSM Parameter Store, as a map of
+               variable name to secret ARN. The task execution role is granted access to these secrets.
+        """
+        pulumi.set(__self__, "image", image)
+        pulumi.set(__self__, "name", name)
+        if depends_on is not None:
+            pulumi.set(__self__, "depends_on", depends_on)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if essential is not None:
+            pulumi.set(__self__, "essential", essential)
+        if health_check is not None:
+            pulumi.set(__self__, "health_check", health_check)
+        if metabase_depends_on is not None:
+            pulumi.set(__self__, "metabase_depends_on", metabase_depends_on)
+        if port_mappings is not None:
+            pulumi.set(__self__, "port_mappings", port_mappings)
+        if secrets is not None:
+            pulumi.set(__self__, "secrets", secrets)
+
+    @property
+    @pulumi.getter
+    def image(self) -> pulumi.Input[str]:
+        """
+        The image to run.
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: pulumi.Input[str]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the container. `metabase`, `log-router` and `plugins-init` are reserved by the component.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="dependsOn")
+    def depends_on(self) -> Optional[Sequence[pulumi.Input['ContainerDependencyArgs']]]:
+        """
+        The other sidecars this container waits for before it's started.
+        """
+        return pulumi.get(self, "depends_on")
+
+    @depends_on.setter
+    def depends_on(self, value: Optional[Sequence[pulumi.Input['ContainerDependencyArgs']]]):
+        pulumi.set(self, "depends_on", value)
+
+    @property
+    @pulumi.getter
+    def environment(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Environment variables for the container.
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "environment", value)
+
+    @property
+    @pulumi.getter
+    def essential(self) -> Optional[bool]:
+        """
+        Whether the task is stopped when the container exits. Defaults to `true`.
+        """
+        return pulumi.get(self, "essential")
+
+    @essential.setter
+    def essential(self, value: Optional[bool]):
+        pulumi.set(self, "essential", value)
+
+    @property
+    @pulumi.getter(name="healthCheck")
+    def health_check(self) -> Optional[pulumi.Input['SidecarHealthCheckArgs']]:
+        """
+        A Docker health check for the container, required to depend on it being `HEALTHY`.
+        """
+        return pulumi.get(self, "health_check")
+
+    @health_check.setter
+    def health_check(self, value: Optional[pulumi.Input['SidecarHealthCheckArgs']]):
+        pulumi.set(self, "health_check", value)
+
+    @property
+    @pulumi.getter(name="metabaseDependsOn")
+    def metabase_depends_on(self) -> Optional[str]:
+        """
+        The condition the container has to reach before Metabase is started, one of `START`, `COMPLETE`,
+        `SUCCESS` or `HEALTHY`. Metabase doesn't wait for the container when not set.
+        """
+        return pulumi.get(self, "metabase_depends_on")
+
+    @metabase_depends_on.setter
+    def metabase_depends_on(self, value: Optional[str]):
+        pulumi.set(self, "metabase_depends_on", value)
+
+    @property
+    @pulumi.getter(name="portMappings")
+    def port_mappings(self) -> Optional[Sequence[pulumi.Input['SidecarPortMappingArgs']]]:
+        """
+        The ports the container listens on. Port 3000 is used by Metabase.
+        """
+        return pulumi.get(self, "port_mappings")
+
+    @port_mappings.setter
+    def port_mappings(self, value: Optional[Sequence[pulumi.Input['SidecarPortMappingArgs']]]):
+        pulumi.set(self, "port_mappings", value)
+
+    @property
+    @pulumi.getter
+    def secrets(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Environment variables whose values are read from Secrets Manager or SSM Parameter Store, as a map of
+        variable name to secret ARN. The task execution role is granted access to these secrets.
+        """
+        return pulumi.get(self, "secrets")
+
+    @secrets.setter
+    def secrets(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "secrets", value)
+
+
+@pulumi.input_type
+class TaskRoleArgs:
+    def __init__(__self__, *,
+                 athena_results_bucket: Optional[pulumi.Input[str]] = None,
+                 inline_policies: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 managed_policy_arns: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 presets: Optional[Sequence[pulumi.Input[str]]] = None):
+        """
+        Options for the IAM role assumed by the Metabase container. Use it to let Metabase connect to data
+        sources such as Athena, S3 or Redshift with IAM credentials.
+
+        :param pulumi.Input[str] athena_results_bucket: The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] inline_policies: Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] managed_policy_arns: The ARNs of IAM managed policies to attach to the task role.
+        :param Sequence[pulumi.Input[str]] presets: Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+               which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+               Access to the S3 buckets backing Athena tables must be granted separately, for example with
+               the `s3-read-only` preset.
+        """
+        if athena_results_bucket is not None:
+            pulumi.set(__self__, "athena_results_bucket", athena_results_bucket)
+        if inline_policies is not None:
+            pulumi.set(__self__, "inline_policies", inline_policies)
+        if managed_policy_arns is not None:
+            pulumi.set(__self__, "managed_policy_arns", managed_policy_arns)
+        if presets is not None:
+            pulumi.set(__self__, "presets", presets)
+
+    @property
+    @pulumi.getter(name="athenaResultsBucket")
+    def athena_results_bucket(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the S3 bucket Athena writes query results to. Required by the `athena-read-only` preset.
+        """
+        return pulumi.get(self, "athena_results_bucket")
+
+    @athena_results_bucket.setter
+    def athena_results_bucket(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "athena_results_bucket", value)
+
+    @property
+    @pulumi.getter(name="inlinePolicies")
+    def inline_policies(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Inline policy documents to add to the task role, as a map of policy name to JSON policy document.
+        """
+        return pulumi.get(self, "inline_policies")
+
+    @inline_policies.setter
+    def inline_policies(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "inline_policies", value)
+
+    @property
+    @pulumi.getter(name="managedPolicyArns")
+    def managed_policy_arns(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The ARNs of IAM managed policies to attach to the task role.
+        """
+        return pulumi.get(self, "managed_policy_arns")
+
+    @managed_policy_arns.setter
+    def managed_policy_arns(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "managed_policy_arns", value)
+
+    @property
+    @pulumi.getter
+    def presets(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        Built-in sets of permissions to attach to the task role. Supported presets are `athena-read-only`,
+        which covers Athena, the Glue Data Catalog and the Athena results bucket, and `s3-read-only`.
+        Access to the S3 buckets backing Athena tables must be granted separately, for example with
+        the `s3-read-only` preset.
+        """
+        return pulumi.get(self, "presets")
+
+    @presets.setter
+    def presets(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "presets", value)
+
+
@@ -14,30 +14,205 @@ __all__ = ['MetabaseArgs', 'Metabase']
 @pulumi.input_type
 class MetabaseArgs:
     def __init__(__self__, *,
+                 allow_downgrade: Optional[bool] = None,
+                 cluster: Optional[pulumi.Input['ClusterArgs']] = None,
+                 compute: Optional[pulumi.Input['ComputeArgs']] = None,
                  database: Optional[pulumi.Input['DatabaseArgs']] = None,
                  domain: Optional[pulumi.Input['CustomDomainArgs']] = None,
+                 ecs_cluster_arn: Optional[pulumi.Input[str]] = None,
+                 edition: Optional[str] = None,
+                 enable_execute_command: Optional[bool] = None,
+                 environment: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 execute_command: Optional[pulumi.Input['ExecuteCommandArgs']] = None,
+                 hardened: Optional[bool] = None,
+                 health_check: Optional[pulumi.Input['HealthCheckArgs']] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 image_mirror: Optional[pulumi.Input['ImageMirrorArgs']] = None,
+                 logging: Optional[pulumi.Input['LoggingArgs']] = None,
                  metabase_version: Optional[pulumi.Input[str]] = None,
                  networking: Optional[pulumi.Input['NetworkingArgs']] = None,
+                 pin_image_digest: Optional[bool] = None,
+                 plugins: Optional[Sequence[pulumi.Input['PluginArgs']]] = None,
+                 premium_embedding_token: Optional[pulumi.Input[str]] = None,
+                 repository_credentials: Optional[pulumi.Input[str]] = None,
+                 schedule: Optional[pulumi.Input['ScheduleArgs']] = None,
+                 secrets: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 settings: Optional[pulumi.Input['SettingsArgs']] = None,
+                 sidecars: Optional[Sequence[pulumi.Input['SidecarArgs']]] = None,
+                 task_role: Optional[pulumi.Input['TaskRoleArgs']] = None,
                  vpc_id: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a Metabase resource.
+        :param bool allow_downgrade: Metabase's database migrations only run forwards, so deploying an older `metabaseVersion` than the one
+               last deployed is refused. The deployed version is recorded in the SSM parameter
+               `/pulumi/metabase/<project>/<stack>/<name>/version`. A deployed tag that doesn't name a release, such as
+               `latest`, can't be compared, so moving from it to a version only logs a warning. Set this to deploy an older
+               version anyway, typically after restoring a database snapshot taken before the upgrade.
+        :param pulumi.Input['ClusterArgs'] cluster: Optionally configure the ECS cluster created for Metabase, such as enabling Container Insights. Can't be
+               used with `ecsClusterArn`.
+        :param pulumi.Input['ComputeArgs'] compute: Optionally configure the Fargate compute Metabase runs on.
         :param pulumi.Input['DatabaseArgs'] database: Optional arguments for configuring your RDS instance.
         :param pulumi.Input['CustomDomainArgs'] domain: Optionally provide a hosted zone and domain name for the Metabase service.
-        :param pulumi.Input[str] metabase_version: The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image.
+        :param pulumi.Input[str] ecs_cluster_arn: The ARN of an existing ECS cluster to run the Metabase service on instead of creating one, which can be
+               the output of another stack. The cluster must be `ACTIVE`, which is checked once the ARN is known. Its
+               Container Insights, capacity provider and ECS Exec settings are left untouched, so the service runs with
+               the `FARGATE` launch type and `compute.capacity`, `cluster` and `executeCommand` can't be used.
+        :param str edition: The Metabase edition to run, either `oss` or `enterprise`.
+        :param bool enable_execute_command: Whether to enable ECS Exec, which lets you open a shell in the Metabase container with
+               `aws ecs execute-command`. The task role is given the SSM permissions the sessions need.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] environment: Additional environment variables for the Metabase container, for example any of the `MB_*`
+               [settings](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables). The
+               `MB_DB_*` variables are managed by the component and can't be set. The variable names must be known
+               before deployment, the values can be outputs of other resources.
+        :param pulumi.Input['ExecuteCommandArgs'] execute_command: Optionally encrypt and log ECS Exec sessions. Requires `enableExecuteCommand`.
+        :param bool hardened: Whether to harden the Metabase container. It runs as the non-root `metabase` user (uid 2000) with a
+               read-only root filesystem, all Linux capabilities dropped, an open files limit of 65536 and 60 seconds
+               to shut down. Fargate doesn't support tmpfs, so the paths Metabase writes to, `/tmp` and the plugins
+               directory, are stored on an EFS file system with a mount target in each of the ECS subnets, which must
+               be in different availability zones and known before deployment. Requires an image that runs as the
+               `metabase` user such as the official images, and can't be used with `enableExecuteCommand`. The FireLens
+               and sidecar containers aren't changed. Defaults to `false`.
+        :param pulumi.Input['HealthCheckArgs'] health_check: Optionally tune the health checks run against the Metabase container.
+        :param pulumi.Input[str] image: A full image reference, such as `registry.example.com/metabase:v0.46.6-drivers`, to run instead of the
+               official Metabase image. The image is used as is, `metabaseVersion` doesn't change its tag. Can't be used
+               with `imageMirror`. The container health check runs `curl`, which a custom image may not ship, so only
+               the load balancer health checks a custom image.
+        :param pulumi.Input['ImageMirrorArgs'] image_mirror: Optionally mirror the Metabase image in a private ECR registry.
+        :param pulumi.Input['LoggingArgs'] logging: Optional arguments for configuring the Metabase container logs.
+        :param pulumi.Input[str] metabase_version: The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image, or the
+               `metabase/metabase-enterprise` image for the `enterprise` edition. Open source tags look like `v0.46.6`
+               and enterprise tags look like `v1.46.6`. When the version changes, a manual snapshot of the application
+               database named `<name>metabase-<old version>-to-<new version>` is taken before the new version is deployed.
+               When the image is pinned with `pinImageDigest`, a tag that moved to a new image, such as `latest`, is
+               snapshotted too and the versions in the name are followed by the first 12 characters of their digests.
+               Unpinned images aren't snapshotted when their tag moves. The snapshot is kept when the component is updated
+               again and has to be deleted by hand.
         :param pulumi.Input['NetworkingArgs'] networking: Optionally provide specific subnet IDs to run the different resources of Metabase.
+        :param bool pin_image_digest: Whether to resolve the image tag to its `sha256` digest at deploy time and run that digest, so every task
+               runs the same image and a change to the tag shows up as a diff. Images in a private ECR registry are
+               looked up with the ECR API, and aren't pinned when the registry is in another region. Images that need
+               `repositoryCredentials` aren't pinned.
+        :param Sequence[pulumi.Input['PluginArgs']] plugins: Plugin JARs to install in Metabase's plugins directory (`MB_PLUGINS_DIR`), such as the ClickHouse or DuckDB
+               community drivers. The plugins directory is stored on an EFS file system with a mount target in each of
+               the ECS subnets, which must be in different availability zones and known before deployment. An init
+               container downloads any missing plugin and checks its checksum before Metabase starts, and removes the
+               plugins it installed that are no longer listed.
+        :param pulumi.Input[str] premium_embedding_token: The Metabase Enterprise token, passed to the container as `MB_PREMIUM_EMBEDDING_TOKEN` through a
+               Secrets Manager secret. Requires the `enterprise` edition.
+        :param pulumi.Input[str] repository_credentials: The ARN of a Secrets Manager secret holding the `username` and `password` of the private registry hosting
+               `image`. The task execution role is granted access to the secret.
+        :param pulumi.Input['ScheduleArgs'] schedule: Optionally stop Metabase outside working hours.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] secrets: Additional environment variables for the Metabase container whose values are read from Secrets
+               Manager or SSM Parameter Store, as a map of variable name to secret ARN. The task execution role is
+               granted access to these secrets. The `MB_DB_*` variables are managed by the component and can't be set.
+               The variable names must be known before deployment, the ARNs can be outputs of other resources such as
+               a secret created in the same program.
+        :param pulumi.Input['SettingsArgs'] settings: Optionally configure commonly used Metabase application settings.
+        :param Sequence[pulumi.Input['SidecarArgs']] sidecars: Extra containers to run in the Metabase task. Their logs are sent to the Metabase log group with the
+               container name as the stream prefix.
+        :param pulumi.Input['TaskRoleArgs'] task_role: Optionally give the Metabase container IAM permissions.
         :param pulumi.Input[str] vpc_id: The VPC to use for the Metabase service. If left blank then the default VPC will be used.
         """
+        if allow_downgrade is not None:
+            pulumi.set(__self__, "allow_downgrade", allow_downgrade)
+        if cluster is not None:
+            pulumi.set(__self__, "cluster", cluster)
+        if compute is not None:
+            pulumi.set(__self__, "compute", compute)
         if database is not None:
             pulumi.set(__self__, "database", database)
         if domain is not None:
             pulumi.set(__self__, "domain", domain)
+        if ecs_cluster_arn is not None:
+            pulumi.set(__self__, "ecs_cluster_arn", ecs_cluster_arn)
+        if edition is None:
+            edition = 'oss'
+        if edition is not None:
+            pulumi.set(__self__, "edition", edition)
+        if enable_execute_command is not None:
+            pulumi.set(__self__, "enable_execute_command", enable_execute_command)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if execute_command is not None:
+            pulumi.set(__self__, "execute_command", execute_command)
+        if hardened is not None:
+            pulumi.set(__self__, "hardened", hardened)
+        if health_check is not None:
+            pulumi.set(__self__, "health_check", health_check)
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if image_mirror is not None:
+            pulumi.set(__self__, "image_mirror", image_mirror)
+        if logging is not None:
+            pulumi.set(__self__, "logging", logging)
         if metabase_version is not None:
             pulumi.set(__self__, "metabase_version", metabase_version)
         if networking is not None:
             pulumi.set(__self__, "networking", networking)
+        if pin_image_digest is None:
+            pin_image_digest = True
+        if pin_image_digest is not None:
+            pulumi.set(__self__, "pin_image_digest", pin_image_digest)
+        if plugins is not None:
+            pulumi.set(__self__, "plugins", plugins)
+        if premium_embedding_token is not None:
+            pulumi.set(__self__, "premium_embedding_token", premium_embedding_token)
+        if repository_credentials is not None:
+            pulumi.set(__self__, "repository_credentials", repository_credentials)
+        if schedule is not None:
+            pulumi.set(__self__, "schedule", schedule)
+        if secrets is not None:
+            pulumi.set(__self__, "secrets", secrets)
+        if settings is not None:
+            pulumi.set(__self__, "settings", settings)
+        if sidecars is not None:
+            pulumi.set(__self__, "sidecars", sidecars)
+        if task_role is not None:
+            pulumi.set(__self__, "task_role", task_role)
         if vpc_id is not None:
             pulumi.set(__self__, "vpc_id", vpc_id)
 
+    @property
+    @pulumi.getter(name="allowDowngrade")
+    def allow_downgrade(self) -> Optional[bool]:
+        """
+        Metabase's database migrations only run forwards, so deploying an older `metabaseVersion` than the one
+        last deployed is refused. The deployed version is recorded in the SSM parameter
+        `/pulumi/metabase/<project>/<stack>/<name>/version`. A deployed tag that doesn't name a release, such as
+        `latest`, can't be compared, so moving from it to a version only logs a warning. Set this to deploy an older
+        version anyway, typically after restoring a database snapshot taken before the upgrade.
+        """
+        return pulumi.get(self, "allow_downgrade")
+
+    @allow_downgrade.setter
+    def allow_downgrade(self, value: Optional[bool]):
+        pulumi.set(self, "allow_downgrade", value)
+
+    @property
+    @pulumi.getter
+    def cluster(self) -> Optional[pulumi.Input['ClusterArgs']]:
+        """
+        Optionally configure the ECS cluster created for Metabase, such as enabling Container Insights. Can't be
+        used with `ecsClusterArn`.
+        """
+        return pulumi.get(self, "cluster")
+
+    @cluster.setter
+    def cluster(self, value: Optional[pulumi.Input['ClusterArgs']]):
+        pulumi.set(self, "cluster", value)
+
+    @property
+    @pulumi.getter
+    def compute(self) -> Optional[pulumi.Input['ComputeArgs']]:
+        """
+        Optionally configure the Fargate compute Metabase runs on.
+        """
+        return pulumi.get(self, "compute")
+
+    @compute.setter
+    def compute(self, value: Optional[pulumi.Input['ComputeArgs']]):
+        pulumi.set(self, "compute", value)
+
     @property
     @pulumi.getter
     def database(self) -> Optional[pulumi.Input['DatabaseArgs']]:
@@ -62,11 +237,154 @@ class MetabaseArgs:
     def domain(self, value: Optional[pulumi.Input['CustomDomainArgs']]):
         pulumi.set(self, "domain", value)
 
+    @property
+    @pulumi.getter(name="ecsClusterArn")
+    def ecs_cluster_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of an existing ECS cluster to run the Metabase service on instead of creating one, which can be
+        the output of another stack. The cluster must be `ACTIVE`, which is checked once the ARN is known. Its
+        Container Insights, capacity provider and ECS Exec settings are left untouched, so the service runs with
+        the `FARGATE` launch type and `compute.capacity`, `cluster` and `executeCommand` can't be used.
+        """
+        return pulumi.get(self, "ecs_cluster_arn")
+
+    @ecs_cluster_arn.setter
+    def ecs_cluster_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ecs_cluster_arn", value)
+
+    @property
+    @pulumi.getter
+    def edition(self) -> Optional[str]:
+        """
+        The Metabase edition to run, either `oss` or `enterprise`.
+        """
+        return pulumi.get(self, "edition")
+
+    @edition.setter
+    def edition(self, value: Optional[str]):
+        pulumi.set(self, "edition", value)
+
+    @property
+    @pulumi.getter(name="enableExecuteCommand")
+    def enable_execute_command(self) -> Optional[bool]:
+        """
+        Whether to enable ECS Exec, which lets you open a shell in the Metabase container with
+        `aws ecs execute-command`. The task role is given the SSM permissions the sessions need.
+        """
+        return pulumi.get(self, "enable_execute_command")
+
+    @enable_execute_command.setter
+    def enable_execute_command(self, value: Optional[bool]):
+        pulumi.set(self, "enable_execute_command", value)
+
+    @property
+    @pulumi.getter
+    def environment(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Additional environment variables for the Metabase container, for example any of the `MB_*`
+        [settings](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables). The
+        `MB_DB_*` variables are managed by the component and can't be set. The variable names must be known
+        before deployment, the values can be outputs of other resources.
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "environment", value)
+
+    @property
+    @pulumi.getter(name="executeCommand")
+    def execute_command(self) -> Optional[pulumi.Input['ExecuteCommandArgs']]:
+        """
+        Optionally encrypt and log ECS Exec sessions. Requires `enableExecuteCommand`.
+        """
+        return pulumi.get(self, "execute_command")
+
+    @execute_command.setter
+    def execute_command(self, value: Optional[pulumi.Input['ExecuteCommandArgs']]):
+        pulumi.set(self, "execute_command", value)
+
+    @property
+    @pulumi.getter
+    def hardened(self) -> Optional[bool]:
+        """
+        Whether to harden the Metabase container. It runs as the non-root `metabase` user (uid 2000) with a
+        read-only root filesystem, all Linux capabilities dropped, an open files limit of 65536 and 60 seconds
+        to shut down. Fargate doesn't support tmpfs, so the paths Metabase writes to, `/tmp` and the plugins
+        directory, are stored on an EFS file system with a mount target in each of the ECS subnets, which must
+        be in different availability zones and known before deployment. Requires an image that runs as the
+        `metabase` user such as the official images, and can't be used with `enableExecuteCommand`. The FireLens
+        and sidecar containers aren't changed. Defaults to `false`.
+        """
+        return pulumi.get(self, "hardened")
+
+    @hardened.setter
+    def hardened(self, value: Optional[bool]):
+        pulumi.set(self, "hardened", value)
+
+    @property
+    @pulumi.getter(name="healthCheck")
+    def health_check(self) -> Optional[pulumi.Input['HealthCheckArgs']]:
+        """
+        Optionally tune the health checks run against the Metabase container.
+        """
+        return pulumi.get(self, "health_check")
+
+    @health_check.setter
+    def health_check(self, value: Optional[pulumi.Input['HealthCheckArgs']]):
+        pulumi.set(self, "health_check", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[pulumi.Input[str]]:
+        """
+        A full image reference, such as `registry.example.com/metabase:v0.46.6-drivers`, to run instead of the
+        official Metabase image. The image is used as is, `metabaseVersion` doesn't change its tag. Can't be used
+        with `imageMirror`. The container health check runs `curl`, which a custom image may not ship, so only
+        the load balancer health checks a custom image.
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter(name="imageMirror")
+    def image_mirror(self) -> Optional[pulumi.Input['ImageMirrorArgs']]:
+        """
+        Optionally mirror the Metabase image in a private ECR registry.
+        """
+        return pulumi.get(self, "image_mirror")
+
+    @image_mirror.setter
+    def image_mirror(self, value: Optional[pulumi.Input['ImageMirrorArgs']]):
+        pulumi.set(self, "image_mirror", value)
+
+    @property
+    @pulumi.getter
+    def logging(self) -> Optional[pulumi.Input['LoggingArgs']]:
+        """
+        Optional arguments for configuring the Metabase container logs.
+        """
+        return pulumi.get(self, "logging")
+
+    @logging.setter
+    def logging(self, value: Optional[pulumi.Input['LoggingArgs']]):
+        pulumi.set(self, "logging", value)
+
     @property
     @pulumi.getter(name="metabaseVersion")
     def metabase_version(self) -> Optional[pulumi.Input[str]]:
         """
-        The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image.
+        The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image, or the
+        `metabase/metabase-enterprise` image for the `enterprise` edition. Open source tags look like `v0.46.6`
+        and enterprise tags look like `v1.46.6`. When the version changes, a manual snapshot of the application
+        database named `<name>metabase-<old version>-to-<new version>` is taken before the new version is deployed.
+        When the image is pinned with `pinImageDigest`, a tag that moved to a new image, such as `latest`, is
+        snapshotted too and the versions in the name are followed by the first 12 characters of their digests.
+        Unpinned images aren't snapshotted when their tag moves. The snapshot is kept when the component is updated
+        again and has to be deleted by hand.
         """
         return pulumi.get(self, "metabase_version")
 
@@ -86,6 +404,128 @@ class MetabaseArgs:
     def networking(self, value: Optional[pulumi.Input['NetworkingArgs']]):
         pulumi.set(self, "networking", value)
 
+    @property
+    @pulumi.getter(name="pinImageDigest")
+    def pin_image_digest(self) -> Optional[bool]:
+        """
+        Whether to resolve the image tag to its `sha256` digest at deploy time and run that digest, so every task
+        runs the same image and a change to the tag shows up as a diff. Images in a private ECR registry are
+        looked up with the ECR API, and aren't pinned when the registry is in another region. Images that need
+        `repositoryCredentials` aren't pinned.
+        """
+        return pulumi.get(self, "pin_image_digest")
+
+    @pin_image_digest.setter
+    def pin_image_digest(self, value: Optional[bool]):
+        pulumi.set(self, "pin_image_digest", value)
+
+    @property
+    @pulumi.getter
+    def plugins(self) -> Optional[Sequence[pulumi.Input['PluginArgs']]]:
+        """
+        Plugin JARs to install in Metabase's plugins directory (`MB_PLUGINS_DIR`), such as the ClickHouse or DuckDB
+        community drivers. The plugins directory is stored on an EFS file system with a mount target in each of
+        the ECS subnets, which must be in different availability zones and known before deployment. An init
+        container downloads any missing plugin and checks its checksum before Metabase starts, and removes the
+        plugins it installed that are no longer listed.
+        """
+        return pulumi.get(self, "plugins")
+
+    @plugins.setter
+    def plugins(self, value: Optional[Sequence[pulumi.Input['PluginArgs']]]):
+        pulumi.set(self, "plugins", value)
+
+    @property
+    @pulumi.getter(name="premiumEmbeddingToken")
+    def premium_embedding_token(self) -> Optional[pulumi.Input[str]]:
+        """
+        The Metabase Enterprise token, passed to the container as `MB_PREMIUM_EMBEDDING_TOKEN` through a
+        Secrets Manager secret. Requires the `enterprise` edition.
+        """
+        return pulumi.get(self, "premium_embedding_token")
+
+    @premium_embedding_token.setter
+    def premium_embedding_token(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "premium_embedding_token", value)
+
+    @property
+    @pulumi.getter(name="repositoryCredentials")
+    def repository_credentials(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of a Secrets Manager secret holding the `username` and `password` of the private registry hosting
+        `image`. The task execution role is granted access to the secret.
+        """
+        return pulumi.get(self, "repository_credentials")
+
+    @repository_credentials.setter
+    def repository_credentials(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "repository_credentials", value)
+
+    @property
+    @pulumi.getter
+    def schedule(self) -> Optional[pulumi.Input['ScheduleArgs']]:
+        """
+        Optionally stop Metabase outside working hours.
+        """
+        return pulumi.get(self, "schedule")
+
+    @schedule.setter
+    def schedule(self, value: Optional[pulumi.Input['ScheduleArgs']]):
+        pulumi.set(self, "schedule", value)
+
+    @property
+    @pulumi.getter
+    def secrets(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Additional environment variables for the Metabase container whose values are read from Secrets
+        Manager or SSM Parameter Store, as a map of variable name to secret ARN. The task execution role is
+        granted access to these secrets. The `MB_DB_*` variables are managed by the component and can't be set.
+        The variable names must be known before deployment, the ARNs can be outputs of other resources such as
+        a secret created in the same program.
+        """
+        return pulumi.get(self, "secrets")
+
+    @secrets.setter
+    def secrets(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "secrets", value)
+
+    @property
+    @pulumi.getter
+    def settings(self) -> Optional[pulumi.Input['SettingsArgs']]:
+        """
+        Optionally configure commonly used Metabase application settings.
+        """
+        return pulumi.get(self, "settings")
+
+    @settings.setter
+    def settings(self, value: Optional[pulumi.Input['SettingsArgs']]):
+        pulumi.set(self, "settings", value)
+
+    @property
+    @pulumi.getter
+    def sidecars(self) -> Optional[Sequence[pulumi.Input['SidecarArgs']]]:
+        """
+        Extra containers to run in the Metabase task. Their logs are sent to the Metabase log group with the
+        container name as the stream prefix.
+        """
+        return pulumi.get(self, "sidecars")
+
+    @sidecars.setter
+    def sidecars(self, value: Optional[Sequence[pulumi.Input['SidecarArgs']]]):
+        pulumi.set(self, "sidecars", value)
+
+    @property
+    @pulumi.getter(name="taskRole")
+    def task_role(self) -> Optional[pulumi.Input['TaskRoleArgs']]:
+        """
+        Optionally give the Metabase container IAM permissions.
+        """
+        return pulumi.get(self, "task_role")
+
+    @task_role.setter
+    def task_role(self, value: Optional[pulumi.Input['TaskRoleArgs']]):
+        pulumi.set(self, "task_role", value)
+
     @property
     @pulumi.getter(name="vpcId")
     def vpc_id(self) -> Optional[pulumi.Input[str]]:
@@ -104,10 +544,32 @@ class Metabase(pulumi.ComponentResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_downgrade: Optional[bool] = None,
+                 cluster: Optional[pulumi.Input[pulumi.InputType['ClusterArgs']]] = None,
+                 compute: Optional[pulumi.Input[pulumi.InputType['ComputeArgs']]] = None,
                  database: Optional[pulumi.Input[pulumi.InputType['DatabaseArgs']]] = None,
                  domain: Optional[pulumi.Input[pulumi.InputType['CustomDomainArgs']]] = None,
+                 ecs_cluster_arn: Optional[pulumi.Input[str]] = None,
+                 edition: Optional[str] = None,
+                 enable_execute_command: Optional[bool] = None,
+                 environment: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 execute_command: Optional[pulumi.Input[pulumi.InputType['ExecuteCommandArgs']]] = None,
+                 hardened: Optional[bool] = None,
+                 health_check: Optional[pulumi.Input[pulumi.InputType['HealthCheckArgs']]] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 image_mirror: Optional[pulumi.Input[pulumi.InputType['ImageMirrorArgs']]] = None,
+                 logging: Optional[pulumi.Input[pulumi.InputType['LoggingArgs']]] = None,
                  metabase_version: Optional[pulumi.Input[str]] = None,
                  networking: Optional[pulumi.Input[pulumi.InputType['NetworkingArgs']]] = None,
+                 pin_image_digest: Optional[bool] = None,
+                 plugins: Optional[Sequence[pulumi.Input[pulumi.InputType['PluginArgs']]]] = None,
+                 premium_embedding_token: Optional[pulumi.Input[str]] = None,
+                 repository_credentials: Optional[pulumi.Input[str]] = None,
+                 schedule: Optional[pulumi.Input[pulumi.InputType['ScheduleArgs']]] = None,
+                 secrets: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 settings: Optional[pulumi.Input[pulumi.InputType['SettingsArgs']]] = None,
+                 sidecars: Optional[Sequence[pulumi.Input[pulumi.InputType['SidecarArgs']]]] = None,
+                 task_role: Optional[pulumi.Input[pulumi.InputType['TaskRoleArgs']]] = None,
                  vpc_id: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
@@ -161,10 +623,74 @@ class Metabase(pulumi.ComponentResource):
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param bool allow_downgrade: Metabase's database migrations only run forwards, so deploying an older `metabaseVersion` than the one
+               last deployed is refused. The deployed version is recorded in the SSM parameter
+               `/pulumi/metabase/<project>/<stack>/<name>/version`. A deployed tag that doesn't name a release, such as
+               `latest`, can't be compared, so moving from it to a version only logs a warning. Set this to deploy an older
+               version anyway, typically after restoring a database snapshot taken before the upgrade.
+        :param pulumi.Input[pulumi.InputType['ClusterArgs']] cluster: Optionally configure the ECS cluster created for Metabase, such as enabling Container Insights. Can't be
+               used with `ecsClusterArn`.
+        :param pulumi.Input[pulumi.InputType['ComputeArgs']] compute: Optionally configure the Fargate compute Metabase runs on.
         :param pulumi.Input[pulumi.InputType['DatabaseArgs']] database: Optional arguments for configuring your RDS instance.
         :param pulumi.Input[pulumi.InputType['CustomDomainArgs']] domain: Optionally provide a hosted zone and domain name for the Metabase service.
-        :param pulumi.Input[str] metabase_version: The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image.
+        :param pulumi.Input[str] ecs_cluster_arn: The ARN of an existing ECS cluster to run the Metabase service on instead of creating one, which can be
+               the output of another stack. The cluster must be `ACTIVE`, which is checked once the ARN is known. Its
+               Container Insights, capacity provider and ECS Exec settings are left untouched, so the service runs with
+               the `FARGATE` launch type and `compute.capacity`, `cluster` and `executeCommand` can't be used.
+        :param str edition: The Metabase edition to run, either `oss` or `enterprise`.
+        :param bool enable_execute_command: Whether to enable ECS Exec, which lets you open a shell in the Metabase container with
+               `aws ecs execute-command`. The task role is given the SSM permissions the sessions need.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] environment: Additional environment variables for the Metabase container, for example any of the `MB_*`
+               [settings](https://www.metabase.com/docs/latest/configuring-metabase/environment-variables). The
+               `MB_DB_*` variables are managed by the component and can't be set. The variable names must be known
+               before deployment, the values can be outputs of other resources.
+        :param pulumi.Input[pulumi.InputType['ExecuteCommandArgs']] execute_command: Optionally encrypt and log ECS Exec sessions. Requires `enableExecuteCommand`.
+        :param bool hardened: Whether to harden the Metabase container. It runs as the non-root `metabase` user (uid 2000) with a
+               read-only root filesystem, all Linux capabilities dropped, an open files limit of 65536 and 60 seconds
+               to shut down. Fargate doesn't support tmpfs, so the paths Metabase writes to, `/tmp` and the plugins
+               directory, are stored on an EFS file system with a mount target in each of the ECS subnets, which must
+               be in different availability zones and known before deployment. Requires an image that runs as the
+               `metabase` user such as the official images, and can't be used with `enableExecuteCommand`. The FireLens
+               and sidecar containers aren't changed. Defaults to `false`.
+        :param pulumi.Input[pulumi.InputType['HealthCheckArgs']] health_check: Optionally tune the health checks run against the Metabase container.
+        :param pulumi.Input[str] image: A full image reference, such as `registry.example.com/metabase:v0.46.6-drivers`, to run instead of the
+               official Metabase image. The image is used as is, `metabaseVersion` doesn't change its tag. Can't be used
+               with `imageMirror`. The container health check runs `curl`, which a custom image may not ship, so only
+               the load balancer health checks a custom image.
+        :param pulumi.Input[pulumi.InputType['ImageMirrorArgs']] image_mirror: Optionally mirror the Metabase image in a private ECR registry.
+        :param pulumi.Input[pulumi.InputType['LoggingArgs']] logging: Optional arguments for configuring the Metabase container logs.
+        :param pulumi.Input[str] metabase_version: The version of Metabase to run - used as a tag on the `metabase/metabase` Dockerhub image, or the
+               `metabase/metabase-enterprise` image for the `enterprise` edition. Open source tags look like `v0.46.6`
+               and enterprise tags look like `v1.46.6`. When the version changes, a manual snapshot of the application
+               database named `<name>metabase-<old version>-to-<new version>` is taken before the new version is deployed.
+               When the image is pinned with `pinImageDigest`, a tag that moved to a new image, such as `latest`, is
+               snapshotted too and the versions in the name are followed by the first 12 characters of their digests.
+               Unpinned images aren't snapshotted when their tag moves. The snapshot is kept when the component is updated
+               again and has to be deleted by hand.
         :param pulumi.Input[pulumi.InputType['NetworkingArgs']] networking: Optionally provide specific subnet IDs to run the different resources of Metabase.
+        :param bool pin_image_digest: Whether to resolve the image tag to its `sha256` digest at deploy time and run that digest, so every task
+               runs the same image and a change to the tag shows up as a diff. Images in a private ECR registry are
+               looked up with the ECR API, and aren't pinned when the registry is in another region. Images that need
+               `repositoryCredentials` aren't pinned.
+        :param Sequence[pulumi.Input[pulumi.InputType['PluginArgs']]] plugins: Plugin JARs to install in Metabase's plugins directory (`MB_PLUGINS_DIR`), such as the ClickHouse or DuckDB
+               community drivers. The plugins directory is stored on an EFS file system with a mount target in each of
+               the ECS subnets, which must be in different availability zones and known before deployment. An init
+               container downloads any missing plugin and checks its checksum before Metabase starts, and removes the
+               plugins it installed that are no longer listed.
+        :param pulumi.Input[str] premium_embedding_token: The Metabase Enterprise token, passed to the container as `MB_PREMIUM_EMBEDDING_TOKEN` through a
+               Secrets Manager secret. Requires the `enterprise` edition.
+        :param pulumi.Input[str] repository_credentials: The ARN of a Secrets Manager secret holding the `username` and `password` of the private registry hosting
+               `image`. The task execution role is granted access to the secret.
+        :param pulumi.Input[pulumi.InputType['ScheduleArgs']] schedule: Optionally stop Metabase outside working hours.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] secrets: Additional environment variables for the Metabase container whose values are read from Secrets
+               Manager or SSM Parameter Store, as a map of variable name to secret ARN. The task execution role is
+               granted access to these secrets. The `MB_DB_*` variables are managed by the component and can't be set.
+               The variable names must be known before deployment, the ARNs can be outputs of other resources such as
+               a secret created in the same program.
+        :param pulumi.Input[pulumi.InputType['SettingsArgs']] settings: Optionally configure commonly used Metabase application settings.
+        :param Sequence[pulumi.Input[pulumi.InputType['SidecarArgs']]] sidecars: Extra containers to run in the Metabase task. Their logs are sent to the Metabase log group with the
+               container name as the stream prefix.
+        :param pulumi.Input[pulumi.InputType['TaskRoleArgs']] task_role: Optionally give the Metabase container IAM permissions.
         :param pulumi.Input[str] vpc_id: The VPC to use for the Metabase service. If left blank then the default VPC will be used.
         """
         ...
@@ -237,10 +763,32 @@ class Metabase(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_downgrade: Optional[bool] = None,
+                 cluster: Optional[pulumi.Input[pulumi.InputType['ClusterArgs']]] = None,
+                 compute: Optional[pulumi.Input[pulumi.InputType['ComputeArgs']]] = None,
                  database: Optional[pulumi.Input[pulumi.InputType['DatabaseArgs']]] = None,
                  domain: Optional[pulumi.Input[pulumi.InputType['CustomDomainArgs']]] = None,
+                 ecs_cluster_arn: Optional[pulumi.Input[str]] = None,
+                 edition: Optional[str] = None,
+                 enable_execute_command: Optional[bool] = None,
+                 environment: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 execute_command: Optional[pulumi.Input[pulumi.InputType['ExecuteCommandArgs']]] = None,
+                 hardened: Optional[bool] = None,
+                 health_check: Optional[pulumi.Input[pulumi.InputType['HealthCheckArgs']]] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 image_mirror: Optional[pulumi.Input[pulumi.InputType['ImageMirrorArgs']]] = None,
+                 logging: Optional[pulumi.Input[pulumi.InputType['LoggingArgs']]] = None,
                  metabase_version: Optional[pulumi.Input[str]] = None,
                  networking: Optional[pulumi.Input[pulumi.InputType['NetworkingArgs']]] = None,
+                 pin_image_digest: Optional[bool] = None,
+                 plugins: Optional[Sequence[pulumi.Input[pulumi.InputType['PluginArgs']]]] = None,
+                 premium_embedding_token: Optional[pulumi.Input[str]] = None,
+                 repository_credentials: Optional[pulumi.Input[str]] = None,
+                 schedule: Optional[pulumi.Input[pulumi.InputType['ScheduleArgs']]] = None,
+                 secrets: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 settings: Optional[pulumi.Input[pulumi.InputType['SettingsArgs']]] = None,
+                 sidecars: Optional[Sequence[pulumi.Input[pulumi.InputType['SidecarArgs']]]] = None,
+                 task_role: Optional[pulumi.Input[pulumi.InputType['TaskRoleArgs']]] = None,
                  vpc_id: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         if opts is None:
@@ -256,13 +804,43 @@ class Metabase(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = MetabaseArgs.__new__(MetabaseArgs)
 
+            __props__.__dict__["allow_downgrade"] = allow_downgrade
+            __props__.__dict__["cluster"] = cluster
+            __props__.__dict__["compute"] = compute
             __props__.__dict__["database"] = database
             __props__.__dict__["domain"] = domain
+            __props__.__dict__["ecs_cluster_arn"] = ecs_cluster_arn
+            if edition is None:
+                edition = 'oss'
+            __props__.__dict__["edition"] = edition
+            __props__.__dict__["enable_execute_command"] = enable_execute_command
+            __props__.__dict__["environment"] = environment
+            __props__.__dict__["execute_command"] = execute_command
+            __props__.__dict__["hardened"] = hardened
+            __props__.__dict__["health_check"] = health_check
+            __props__.__dict__["image"] = image
+            __props__.__dict__["image_mirror"] = image_mirror
+            __props__.__dict__["logging"] = logging
             __props__.__dict__["metabase_version"] = metabase_version
             __props__.__dict__["networking"] = networking
+            if pin_image_digest is None:
+                pin_image_digest = True
+            __props__.__dict__["pin_image_digest"] = pin_image_digest
+            __props__.__dict__["plugins"] = plugins
+            __props__.__dict__["premium_embedding_token"] = None if premium_embedding_token is None else pulumi.Output.secret(premium_embedding_token)
+            __props__.__dict__["repository_credentials"] = repository_credentials
+            __props__.__dict__["schedule"] = schedule
+            __props__.__dict__["secrets"] = secrets
+            __props__.__dict__["settings"] = settings
+            __props__.__dict__["sidecars"] = sidecars
+            __props__.__dict__["task_role"] = task_role
             __props__.__dict__["vpc_id"] = vpc_id
             __props__.__dict__["dns_name"] = None
+            __props__.__dict__["image_digest"] = None
+            __props__.__dict__["image_repository"] = None
+            __props__.__dict__["log_group_name"] = None
             __props__.__dict__["security_group_id"] = None
+            __props__.__dict__["task_role_arn"] = None
         super(Metabase, __self__).__init__(
             'metabase:index:Metabase',
             resource_name,
@@ -278,6 +856,30 @@ class Metabase(pulumi.ComponentResource):
         """
         return pulumi.get(self, "dns_name")
 
+    @property
+    @pulumi.getter(name="imageDigest")
+    def image_digest(self) -> pulumi.Output[str]:
+        """
+        The digest the Metabase image is pinned to, empty when the image isn't pinned.
+        """
+        return pulumi.get(self, "image_digest")
+
+    @property
+    @pulumi.getter(name="imageRepository")
+    def image_repository(self) -> pulumi.Output[str]:
+        """
+        The repository the Metabase image is pulled from.
+        """
+        return pulumi.get(self, "image_repository")
+
+    @property
+    @pulumi.getter(name="logGroupName")
+    def log_group_name(self) -> pulumi.Output[str]:
+        """
+        The name of the CloudWatch log group the Metabase container logs are sent to.
+        """
+        return pulumi.get(self, "log_group_name")
+
     @property
     @pulumi.getter(name="securityGroupId")
     def security_group_id(self) -> pulumi.Output[str]:
@@ -286,3 +888,11 @@ class Metabase(pulumi.ComponentResource):
         """
         return pulumi.get(self, "security_group_id")
 
+    @property
+    @pulumi.getter(name="taskRoleArn")
+    def task_role_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the IAM role assumed by the Metabase container.
+        """
+        return pulumi.get(self, "task_role_arn")
+